@@ -0,0 +1,129 @@
+package skiplist
+
+import "sync"
+
+type (
+	// Allocator provides list elements.
+	Allocator[T any] interface {
+		// Alloc returns element with tower of height h.
+		// NewEl can be used to make one.
+		Alloc(h int) *El[T]
+
+		// Free takes back element removed from the list if auto reuse is enabled.
+		Free(e *El[T])
+	}
+
+	// Layout defines how element towers are allocated.
+	Layout int
+
+	poolAlloc[T any] struct {
+		p      *sync.Pool
+		layout Layout
+	}
+
+	// elements with links allocated at once
+	el2[T any] struct {
+		El[T]
+		more [1]link[T]
+	}
+
+	el3[T any] struct {
+		El[T]
+		more [2]link[T]
+	}
+
+	elFixed[T any] struct {
+		El[T]
+		more [FixedHeight - 1]link[T]
+	}
+)
+
+const (
+	// FixedLayout allocates element with FixedHeight links at once whatever its height is.
+	// Taller elements take one more allocation. It's the default.
+	FixedLayout Layout = iota
+
+	// CompactLayout allocates element with as many links as it has
+	// for elements up to FixedHeight. Half of elements are of height 1,
+	// so it takes about 30% less memory than FixedLayout,
+	// but pooled elements can be reused only for not taller ones.
+	CompactLayout
+)
+
+// pool is the package global pool used by Reuse.
+// Elements of a different type taken from it are left for GC.
+var pool sync.Pool
+
+// NewEl allocates new element with tower of height h in FixedLayout.
+func NewEl[T any](h int) *El[T] {
+	return newEl[T](h, FixedLayout)
+}
+
+func newEl[T any](h int, layout Layout) *El[T] {
+	var e *El[T]
+
+	switch {
+	case h > FixedHeight:
+		e = &El[T]{more: make([]link[T], h-1)}
+	case layout == FixedLayout || h == FixedHeight:
+		b := &elFixed[T]{}
+		e = &b.El
+		e.more = b.more[:]
+	case h == 1:
+		e = &El[T]{}
+	case h == 2:
+		b := &el2[T]{}
+		e = &b.El
+		e.more = b.more[:]
+	default:
+		b := &el3[T]{}
+		e = &b.El
+		e.more = b.more[:]
+	}
+
+	e.h = h
+	e.more = e.more[:h-1]
+
+	return e
+}
+
+// Height returns element tower height.
+func (e *El[T]) Height() int {
+	if debug {
+		e.check()
+	}
+
+	return e.h
+}
+
+// poison marks released element so that any later access to it panics.
+// It's only used with skiplistdebug build tag.
+func (e *El[T]) poison() {
+	*e = El[T]{h: -1}
+}
+
+func (e *El[T]) check() {
+	if e.h < 0 {
+		panic("skiplist: use of released element")
+	}
+}
+
+func (a poolAlloc[T]) Alloc(h int) *El[T] {
+	e, ok := a.p.Get().(*El[T])
+	if !ok || cap(e.more) < h-1 {
+		return newEl[T](h, a.layout)
+	}
+
+	e.h = h
+	e.more = e.more[:h-1]
+
+	return e
+}
+
+func (a poolAlloc[T]) Free(e *El[T]) {
+	var zero T
+
+	e.val = zero
+	clear(e.more)
+	a.p.Put(e)
+}
@@ -0,0 +1,211 @@
+package skiplist
+
+import (
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type countAlloc[T any] struct {
+	alloc, free int
+	free1       []*El[T]
+}
+
+func (a *countAlloc[T]) Alloc(h int) *El[T] {
+	a.alloc++
+
+	if l := len(a.free1); h == 1 && l != 0 {
+		e := a.free1[l-1]
+		a.free1 = a.free1[:l-1]
+		return e
+	}
+
+	return NewEl[T](h)
+}
+
+func (a *countAlloc[T]) Free(e *El[T]) {
+	a.free++
+
+	if e.Height() == 1 {
+		a.free1 = append(a.free1, e)
+	}
+}
+
+func TestAllocator(t *testing.T) {
+	a := &countAlloc[int]{}
+
+	l := NewWithOptions(IntLess, WithAllocator[int](a), WithAutoReuse(true), WithSeed(*seed))
+
+	for i := 0; i < 100; i++ {
+		l.Put(i)
+	}
+
+	for i := 0; i < 100; i += 2 {
+		l.Del(i)
+	}
+
+	for i := 0; i < 100; i += 2 {
+		l.Put(i)
+	}
+
+	assert.Equal(t, 150, a.alloc)
+
+	if !debug {
+		assert.Equal(t, 50, a.free)
+	}
+	assert.Equal(t, 100, l.Len())
+
+	checkDist(t, l)
+
+	assert.Panics(t, func() {
+		NewWithOptions(StringLess, WithAllocator[int](a))
+	})
+}
+
+func TestPoolAndMetrics(t *testing.T) {
+	var p sync.Pool
+	var puts, dels int
+	hist := make(map[int]int)
+
+	l := NewWithOptions(IntLess,
+		WithRepeat(true),
+		WithPool(&p),
+		WithMetrics(Metrics{
+			Put: func(h int) { puts++; hist[h]++ },
+			Del: func(h int) { dels++; hist[h]-- },
+		}),
+	)
+
+	for i := 0; i < 10; i++ {
+		l.Put(1)
+	}
+
+	for i := 0; i < 4; i++ {
+		l.Del(1)
+	}
+
+	assert.Equal(t, 6, l.Len())
+	assert.Equal(t, 10, puts)
+	assert.Equal(t, 4, dels)
+
+	for e := l.First(); e != nil; e = e.Next() {
+		hist[e.Height()]--
+	}
+
+	for h, n := range hist {
+		assert.Equal(t, 0, n, "height %d", h)
+	}
+}
+
+func TestRelease(t *testing.T) {
+	if debug {
+		t.Skip("released elements are poisoned instead of freed")
+	}
+
+	a := &countAlloc[int]{}
+
+	l := NewWithOptions(IntLess, WithAllocator[int](a), WithSeed(*seed))
+
+	e, _ := l.Put(1)
+	l.Put(2)
+
+	v, ok := l.Del(1)
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, 0, a.free)
+
+	assert.Equal(t, 1, e.Value(), "deleted element is not reused until released")
+
+	assert.Panics(t, func() { l.Release(l.First()) }, "live element")
+
+	l.Release(e)
+	assert.Equal(t, 1, a.free)
+
+	assert.Panics(t, func() { l.Release(e) }, "double release")
+	assert.Equal(t, 1, a.free)
+
+	l.SetAutoReuse(true)
+
+	assert.Panics(t, func() { l.Release(l.First()) })
+
+	v, ok = l.Del(2)
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+	assert.Equal(t, 2, a.free)
+}
+
+func TestLayout(t *testing.T) {
+	for _, layout := range []Layout{FixedLayout, CompactLayout} {
+		l := NewWithOptions(IntLess, WithLayout(layout), WithAutoReuse(true), WithSeed(*seed))
+
+		for i := 0; i < 1000; i++ {
+			l.Put(rnd.Intn(500))
+		}
+
+		for i := 0; i < 1000; i++ {
+			l.Del(rnd.Intn(500))
+			l.Put(rnd.Intn(500))
+		}
+
+		checkDist(t, l)
+
+		for h := 1; h <= 6; h++ {
+			e := newEl[int](h, layout)
+
+			assert.Equal(t, h, e.Height())
+			assert.Len(t, e.more, h-1)
+		}
+	}
+}
+
+func BenchmarkLayoutMemory(b *testing.B) {
+	const N = 1000000
+
+	vals := make([]int, N)
+	for i := range vals {
+		vals[i] = i
+	}
+
+	for _, tc := range []struct {
+		name string
+		opt  Option
+	}{
+		{"fixed", WithLayout(FixedLayout)},
+		{"compact", WithLayout(CompactLayout)},
+		{"arena", WithAllocator[int](NewArena[int](4096))},
+	} {
+		b.Run(tc.name, func(b *testing.B) {
+			var before, after runtime.MemStats
+
+			for i := 0; i < b.N; i++ {
+				runtime.GC()
+				runtime.ReadMemStats(&before)
+
+				l := FromSorted(IntLess, vals, tc.opt, WithSeed(*seed))
+
+				runtime.GC()
+				runtime.ReadMemStats(&after)
+				runtime.KeepAlive(l)
+
+				b.ReportMetric(float64(after.HeapAlloc-before.HeapAlloc)/N, "B/el")
+			}
+		})
+	}
+}
+
+func TestPoolFreeClears(t *testing.T) {
+	var p sync.Pool
+
+	a := poolAlloc[*int]{p: &p}
+
+	e := a.Alloc(2)
+	e.val = new(int)
+	e.more[0].dist = 1
+
+	a.Free(e)
+
+	assert.Nil(t, e.val)
+	assert.Equal(t, link[*int]{}, e.more[0])
+}
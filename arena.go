@@ -0,0 +1,87 @@
+package skiplist
+
+// Arena is an Allocator carving elements and their towers from big chunks.
+// Elements and tower links come from separate chunks, so an element
+// and its upper links are not one contiguous block of memory.
+// It makes a few big allocations instead of one or two per element.
+// Freed elements are kept in per height free lists for reuse.
+//
+// Arena is not safe for concurrent use and must not be shared by lists
+// which are reset, as Reset drops all the elements.
+type Arena[T any] struct {
+	chunk int
+
+	els   []El[T]
+	links []link[T]
+
+	free [][]*El[T] // by height
+}
+
+var _ Allocator[int] = &Arena[int]{}
+
+// NewArena creates Arena allocating chunk elements at once. 256 is used if chunk <= 0.
+func NewArena[T any](chunk int) *Arena[T] {
+	if chunk <= 0 {
+		chunk = 256
+	}
+
+	return &Arena[T]{chunk: chunk}
+}
+
+// Alloc takes freed element of height h or carves new one.
+func (a *Arena[T]) Alloc(h int) *El[T] {
+	if h < len(a.free) {
+		if n := len(a.free[h]); n != 0 {
+			e := a.free[h][n-1]
+			a.free[h] = a.free[h][:n-1]
+
+			e.h = h
+
+			return e
+		}
+	}
+
+	if len(a.els) == 0 {
+		a.els = make([]El[T], a.chunk)
+	}
+
+	e := &a.els[0]
+	a.els = a.els[1:]
+
+	e.h = h
+
+	if n := h - 1; n > 0 {
+		if len(a.links) < n {
+			a.links = make([]link[T], max(a.chunk, n))
+		}
+
+		e.more = a.links[:n:n]
+		a.links = a.links[n:]
+	}
+
+	return e
+}
+
+// Free puts element to the free list.
+func (a *Arena[T]) Free(e *El[T]) {
+	more := e.more
+	clear(more)
+
+	*e = El[T]{more: more}
+
+	h := len(more) + 1 // allocated height, it's bigger than e.h if list has back links
+
+	for len(a.free) <= h {
+		a.free = append(a.free, nil)
+	}
+
+	a.free[h] = append(a.free[h], e)
+}
+
+// Reset drops all the elements allocated in O(1).
+// They are left for GC, which collects chunks once they are not referenced.
+func (a *Arena[T]) Reset() {
+	a.els = nil
+	a.links = nil
+	a.free = nil
+}
@@ -0,0 +1,97 @@
+package skiplist
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArena(t *testing.T) {
+	const N = 3000
+
+	a := NewArena[int](64)
+	l := NewWithOptions(IntLess, WithAllocator[int](a), WithAutoReuse(true), WithRepeat(true), WithP(0.75), WithSeed(*seed))
+
+	exp := make(map[int]int)
+
+	for i := 0; i < N; i++ {
+		v := rnd.Intn(N / 4)
+
+		if rnd.Intn(3) == 0 {
+			if _, ok := l.Del(v); ok {
+				exp[v]--
+			}
+
+			continue
+		}
+
+		l.Put(v)
+		exp[v]++
+	}
+
+	checkDist(t, l)
+
+	for e := l.First(); e != nil; e = e.Next() {
+		exp[e.Value()]--
+	}
+
+	for v, n := range exp {
+		assert.Equal(t, 0, n, "value %d", v)
+	}
+
+	l.Reset()
+
+	assert.Equal(t, 0, l.Len())
+	assert.Nil(t, l.First())
+	assert.Nil(t, a.els)
+
+	for i := 0; i < 100; i++ {
+		l.Put(i)
+	}
+
+	assert.Equal(t, 100, l.Len())
+	checkDist(t, l)
+}
+
+func TestReset(t *testing.T) {
+	l := New(IntLess, WithSeed(*seed))
+
+	for i := 0; i < 100; i++ {
+		l.Put(i)
+	}
+
+	l.Reset()
+
+	assert.Equal(t, 0, l.Len())
+	assert.Nil(t, l.First())
+	assert.Nil(t, l.Last())
+
+	l.Put(3)
+	l.Put(1)
+
+	assert.Equal(t, []int{1, 3}, slices.Collect(l.All()))
+	checkDist(t, l)
+}
+
+func BenchmarkArenaPut(b *testing.B) {
+	b.Run("pool", func(b *testing.B) {
+		benchmarkPutReset(b, New(IntLess, WithSeed(*seed)))
+	})
+
+	b.Run("arena", func(b *testing.B) {
+		benchmarkPutReset(b, NewWithOptions(IntLess, WithAllocator[int](NewArena[int](1024)), WithSeed(*seed)))
+	})
+}
+
+func benchmarkPutReset(b *testing.B, l *List[int]) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		if i%10000 == 0 {
+			l.Reset()
+		}
+
+		l.Put(i)
+	}
+}
@@ -0,0 +1,81 @@
+package skiplist
+
+// FromSorted creates list from sorted values in O(n).
+func FromSorted[T any](less LessFunc[T], vals []T, opts ...Option) *List[T] {
+	l := NewWithOptions(less, opts...)
+	l.PutSorted(vals)
+
+	return l
+}
+
+// PutSorted puts sorted values the same way Put does.
+// It's O(n) if values are appended to the end and O(n log(N/n)) if merged into the list of length N.
+// Unsorted values are still put correctly but may cost a full search each.
+func (l *List[T]) PutSorted(vals []T) (added int) {
+	return l.PutBatch(vals)
+}
+
+// PutBatch puts values the same way Put does.
+// Each search starts from the previous insertion point so a value costs O(log d),
+// where d is the distance from the previous one.
+// Value less than the previous one costs a full search from the head.
+// It returns number of added elements.
+func (l *List[T]) PutBatch(vals []T) (added int) {
+	for i, v := range vals {
+		if i == 0 || l.up[0] != &l.zero && l.less(v, l.up[0].val) {
+			l.find(v, false, true)
+		} else {
+			l.advance(v)
+		}
+
+		cur := l.up[0]
+		if !l.repeat && cur != &l.zero && !l.less(cur.val, v) {
+			cur.val = v
+			continue
+		}
+
+		pos := l.pos[0] + 1
+		e := l.rndEl(v)
+		added++
+
+		for j := 0; j < e.height(); j++ {
+			l.up[j] = e
+			l.pos[j] = pos
+		}
+	}
+
+	return added
+}
+
+// advance moves l.up frontier forward to the last elements not greater than v.
+// Frontier must be not after v already.
+func (l *List[T]) advance(v T) {
+	top := 0
+	for top < len(l.up) {
+		n := l.up[top].nexti(top)
+		if n == nil || l.less(v, n.val) {
+			break
+		}
+
+		top++
+	}
+
+	for i := top - 1; i >= 0; i-- {
+		cur, pos := l.up[i], l.pos[i]
+		if i+1 < len(l.up) && l.pos[i+1] > pos {
+			cur, pos = l.up[i+1], l.pos[i+1]
+		}
+
+		for {
+			n := cur.nexti(i)
+			if n == nil || l.less(v, n.val) {
+				break
+			}
+
+			pos += n.link(i).dist
+			cur = n
+		}
+
+		l.up[i], l.pos[i] = cur, pos
+	}
+}
@@ -0,0 +1,110 @@
+package skiplist
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromSorted(t *testing.T) {
+	const N = 10000
+
+	var calls int
+	less := func(a, b int) bool {
+		calls++
+		return a < b
+	}
+
+	vals := make([]int, N)
+	for i := range vals {
+		vals[i] = i
+	}
+
+	l := FromSorted(less, vals, WithSeed(*seed))
+
+	assert.Equal(t, N, l.Len())
+	assert.Equal(t, vals, slices.Collect(l.All()))
+	assert.True(t, calls < 3*N, "too many calls: %d", calls)
+	checkDist(t, l)
+
+	t.Logf("less calls: %d for %d elements", calls, N)
+}
+
+func TestPutSortedMerge(t *testing.T) {
+	l := New(IntLess, WithSeed(*seed))
+
+	l.PutSorted([]int{0, 2, 4, 6, 8, 10})
+	added := l.PutSorted([]int{1, 2, 3, 3, 7, 11, 12})
+
+	assert.Equal(t, 5, added)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 6, 7, 8, 10, 11, 12}, slices.Collect(l.All()))
+	checkDist(t, l)
+
+	r := NewRepeated(IntLess, WithSeed(*seed))
+
+	r.PutSorted([]int{1, 1, 2, 3})
+	r.PutSorted([]int{1, 2, 2, 4})
+
+	assert.Equal(t, []int{1, 1, 1, 2, 2, 2, 3, 4}, slices.Collect(r.All()))
+	checkDist(t, r)
+}
+
+func TestPutBatch(t *testing.T) {
+	const N = 5000
+
+	l := NewRepeated(IntLess, WithSeed(*seed))
+	var exp []int
+
+	vals := make([]int, N)
+	for i := range vals {
+		vals[i] = i + rnd.Intn(50) - 25 // nearly sorted
+	}
+
+	for j := 0; j < 3; j++ {
+		added := l.PutBatch(vals)
+		assert.Equal(t, N, added)
+
+		exp = append(exp, vals...)
+	}
+
+	l.PutBatch(nil)
+
+	slices.Sort(exp)
+
+	assert.Equal(t, exp, slices.Collect(l.All()))
+	checkDist(t, l)
+
+	for i := 0; i < N; i++ {
+		l.Del(rnd.Intn(N))
+	}
+
+	checkDist(t, l)
+
+	vals = vals[:0]
+	for i := 0; i < N; i++ {
+		vals = append(vals, rnd.Intn(N))
+	}
+
+	u := New(IntLess)
+	u.PutBatch(vals)
+
+	slices.Sort(vals)
+	vals = slices.Compact(vals)
+
+	assert.Equal(t, vals, slices.Collect(u.All()))
+	checkDist(t, u)
+}
+
+func BenchmarkFromSorted(b *testing.B) {
+	b.ReportAllocs()
+
+	vals := make([]int, b.N)
+	for i := range vals {
+		vals[i] = i
+	}
+
+	b.ResetTimer()
+
+	_ = FromSorted(IntLess, vals)
+}
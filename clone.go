@@ -0,0 +1,66 @@
+package skiplist
+
+// Clear deletes all elements.
+// If release is set each element is released to the allocator
+// and reported to Metrics, which is O(n). It's O(1) overwise.
+// Released elements must not be used after that as with Release.
+func (l *List[T]) Clear(release bool) {
+	if release {
+		for e := l.First(); e != nil; {
+			next := e.Next()
+
+			if l.metrics.Del != nil {
+				l.metrics.Del(e.height())
+			}
+
+			l.release(e)
+
+			e = next
+		}
+	}
+
+	l.clear()
+	clear(l.up)
+}
+
+// Clone returns independent copy of the list in O(n).
+// Elements have the same tower heights as l ones.
+// The copy has the same settings and uses the same allocator.
+func (l *List[T]) Clone() *List[T] {
+	return l.CloneFunc(func(v T) T { return v })
+}
+
+// CloneFunc is like Clone but values are copied by f.
+// f must keep the order.
+func (l *List[T]) CloneFunc(f func(T) T) *List[T] {
+	r := l.empty()
+	r.front()
+
+	for e := l.First(); e != nil; e = e.Next() {
+		r.push(f(e.val), e.height())
+	}
+
+	return r
+}
+
+// front sets l.up and l.pos to the list head.
+func (l *List[T]) front() {
+	for i := range l.up {
+		l.up[i] = &l.zero
+		l.pos[i] = 0
+	}
+}
+
+// push appends new element of height h after l.up elements,
+// which must be the last ones, and moves them forward.
+func (l *List[T]) push(v T, h int) *El[T] {
+	pos := l.len + 1
+	e := l.insert(v, h, len(l.up))
+
+	for i := 0; i < h; i++ {
+		l.up[i] = e
+		l.pos[i] = pos
+	}
+
+	return e
+}
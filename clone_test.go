@@ -0,0 +1,85 @@
+package skiplist
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClear(t *testing.T) {
+	a := &countAlloc[int]{}
+	dels := 0
+
+	l := NewWithOptions(IntLess, WithAllocator[int](a), WithMetrics(Metrics{Del: func(int) { dels++ }}), WithSeed(*seed))
+
+	for i := 0; i < 100; i++ {
+		l.Put(i)
+	}
+
+	l.Clear(false)
+
+	assert.Equal(t, 0, l.Len())
+	assert.Nil(t, l.First())
+	assert.Equal(t, 0, dels)
+
+	for i := 0; i < 100; i++ {
+		l.Put(i)
+	}
+
+	l.Clear(true)
+
+	assert.Equal(t, 0, l.Len())
+	assert.Nil(t, l.Last())
+	assert.Equal(t, 100, dels)
+
+	if !debug {
+		assert.Equal(t, 100, a.free)
+	}
+
+	l.Put(1)
+	checkDist(t, l)
+}
+
+func TestClone(t *testing.T) {
+	l := NewRepeated(IntLess, WithBackLinks(true), WithSeed(*seed))
+
+	for i := 0; i < 1000; i++ {
+		l.Put(rnd.Intn(300))
+	}
+
+	r := l.Clone()
+
+	assert.Equal(t, slices.Collect(l.All()), slices.Collect(r.All()))
+	checkDist(t, r)
+
+	for x, y := l.First(), r.First(); x != nil; x, y = x.Next(), y.Next() {
+		assert.True(t, x != y)
+		assert.Equal(t, x.Height(), y.Height())
+	}
+
+	r.Put(1000)
+	l.Del(l.First().Value())
+
+	assert.Equal(t, 999, l.Len())
+	assert.Equal(t, 1001, r.Len())
+
+	type box struct{ v *int }
+
+	b := New(func(a, b box) bool { return *a.v < *b.v })
+
+	for i := 0; i < 10; i++ {
+		b.Put(box{v: &i})
+	}
+
+	c := b.CloneFunc(func(x box) box {
+		v := *x.v
+		return box{v: &v}
+	})
+
+	*b.First().Value().v = -1
+
+	assert.Equal(t, 0, *c.First().Value().v)
+	assert.Equal(t, 10, c.Len())
+	checkDist(t, c)
+}
@@ -0,0 +1,291 @@
+package skiplist
+
+import (
+	"iter"
+	"sync/atomic"
+)
+
+// concurrentMaxHeight limits ConcurrentList height so that search state fits on stack.
+const concurrentMaxHeight = 64
+
+type (
+	// ConcurrentList is a lock-free skiplist safe for concurrent use.
+	// Elements are unique, Put overwrites existing value.
+	// Deleted nodes are marked first and unlinked by subsequent operations.
+	ConcurrentList[T any] struct {
+		heights
+
+		less LessFunc[T]
+		head cnode[T]
+		len  atomic.Int64
+	}
+
+	cnode[T any] struct {
+		key  T
+		val  atomic.Pointer[T] // nil once node is deleted
+		next []atomic.Pointer[cref[T]]
+	}
+
+	// cref is an immutable next pointer with the deletion mark of its owner.
+	cref[T any] struct {
+		n      *cnode[T]
+		marked bool
+	}
+)
+
+// NewConcurrent creates lock-free skiplist without repeated elements.
+// Nodes are allocated by GC as they can't be reused safely,
+// so it accepts height options only and panics on others.
+func NewConcurrent[T any](less LessFunc[T], opts ...Option) *ConcurrentList[T] {
+	l := &ConcurrentList[T]{
+		heights: newHeights(opts),
+		less:    less,
+	}
+
+	if l.maxh > concurrentMaxHeight {
+		l.maxh = concurrentMaxHeight
+	}
+
+	l.head.next = make([]atomic.Pointer[cref[T]], l.maxh)
+
+	end := &cref[T]{}
+	for i := range l.head.next {
+		l.head.next[i].Store(end)
+	}
+
+	return l
+}
+
+// Len returns length of list.
+// It's exact only if there are no concurrent modifications.
+func (l *ConcurrentList[T]) Len() int {
+	return int(l.len.Load())
+}
+
+// Get returns value equal to v.
+// Second returned argument is false if it doesn't exist.
+func (l *ConcurrentList[T]) Get(v T) (T, bool) {
+	pred := &l.head
+	var cur *cnode[T]
+
+	for i := len(pred.next) - 1; i >= 0; i-- {
+		cur = pred.next[i].Load().n
+
+		for cur != nil {
+			r := cur.next[i].Load()
+			for r.marked {
+				cur = r.n
+				if cur == nil {
+					break
+				}
+
+				r = cur.next[i].Load()
+			}
+
+			if cur == nil || !l.less(cur.key, v) {
+				break
+			}
+
+			pred = cur
+			cur = r.n
+		}
+	}
+
+	if cur == nil || l.less(v, cur.key) {
+		var zero T
+		return zero, false
+	}
+
+	p := cur.val.Load()
+	if p == nil {
+		var zero T
+		return zero, false
+	}
+
+	return *p, true
+}
+
+// Put puts new value or overwrites existing.
+// It returns true if there wasn't such element.
+func (l *ConcurrentList[T]) Put(v T) bool {
+	_, added := l.put(v, true)
+
+	return added
+}
+
+// GetOrPut returns existing value equal to v or adds v.
+// Second returned argument is true if there wasn't such element.
+func (l *ConcurrentList[T]) GetOrPut(v T) (T, bool) {
+	return l.put(v, false)
+}
+
+// Del deletes element equal to v and returns its value.
+// Second returned argument is false if it wasn't existed.
+func (l *ConcurrentList[T]) Del(v T) (T, bool) {
+	var preds, succs [concurrentMaxHeight]*cnode[T]
+	var prefs [concurrentMaxHeight]*cref[T]
+
+	for {
+		if !l.find(v, preds[:], prefs[:], succs[:]) {
+			var zero T
+			return zero, false
+		}
+
+		cur := succs[0]
+
+		for i := len(cur.next) - 1; i >= 1; i-- {
+			for r := cur.next[i].Load(); !r.marked; r = cur.next[i].Load() {
+				cur.next[i].CompareAndSwap(r, &cref[T]{n: r.n, marked: true})
+			}
+		}
+
+		for r := cur.next[0].Load(); !r.marked; r = cur.next[0].Load() {
+			if !cur.next[0].CompareAndSwap(r, &cref[T]{n: r.n, marked: true}) {
+				continue
+			}
+
+			p := cur.val.Swap(nil) // concurrent Put can't overwrite it after that
+
+			l.len.Add(-1)
+
+			l.find(v, preds[:], prefs[:], succs[:]) // unlink
+
+			return *p, true
+		}
+
+		// someone else deleted it, but there could be new one already
+	}
+}
+
+// All returns iterator over values in order.
+// It's weakly consistent: concurrent modifications may or may not be observed.
+func (l *ConcurrentList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for cur := l.head.next[0].Load().n; cur != nil; {
+			r := cur.next[0].Load()
+
+			if p := cur.val.Load(); !r.marked && p != nil && !yield(*p) {
+				return
+			}
+
+			cur = r.n
+		}
+	}
+}
+
+func (l *ConcurrentList[T]) put(v T, overwrite bool) (T, bool) {
+	var preds, succs [concurrentMaxHeight]*cnode[T]
+	var prefs [concurrentMaxHeight]*cref[T]
+
+	h := l.rndHeight()
+
+	var n *cnode[T]
+
+	for {
+		if l.find(v, preds[:], prefs[:], succs[:]) {
+			cur := succs[0]
+
+			// p is nil if cur is being deleted, it's to be unlinked by the next find then
+			if p := cur.val.Load(); p != nil {
+				if !overwrite {
+					return *p, false
+				}
+
+				if cur.val.CompareAndSwap(p, &v) {
+					return v, false
+				}
+			}
+
+			continue
+		}
+
+		if n == nil {
+			n = &cnode[T]{key: v, next: make([]atomic.Pointer[cref[T]], h)}
+			n.val.Store(&v)
+		}
+
+		for i := 0; i < h; i++ {
+			n.next[i].Store(&cref[T]{n: succs[i]})
+		}
+
+		if preds[0].next[0].CompareAndSwap(prefs[0], &cref[T]{n: n}) {
+			break
+		}
+	}
+
+	l.len.Add(1)
+
+	for i := 1; i < h; i++ {
+		for {
+			r := n.next[i].Load()
+			if r.marked {
+				return v, true
+			}
+
+			if r.n != succs[i] && !n.next[i].CompareAndSwap(r, &cref[T]{n: succs[i]}) {
+				continue
+			}
+
+			if preds[i].next[i].CompareAndSwap(prefs[i], &cref[T]{n: n}) {
+				break
+			}
+
+			if !l.find(v, preds[:], prefs[:], succs[:]) || succs[0] != n {
+				return v, true
+			}
+		}
+	}
+
+	return v, true
+}
+
+// find fills preds and succs with neighbors of v at each level unlinking marked nodes on the way.
+// prefs are preds next pointers loaded.
+// It returns true if succs[0] is equal to v.
+func (l *ConcurrentList[T]) find(v T, preds []*cnode[T], prefs []*cref[T], succs []*cnode[T]) bool {
+retry:
+	pred := &l.head
+	var cur *cnode[T]
+
+	for i := len(pred.next) - 1; i >= 0; i-- {
+		pr := pred.next[i].Load()
+		if pr.marked {
+			goto retry
+		}
+
+		cur = pr.n
+
+		for cur != nil {
+			r := cur.next[i].Load()
+
+			for r.marked {
+				nr := &cref[T]{n: r.n}
+				if !pred.next[i].CompareAndSwap(pr, nr) {
+					goto retry
+				}
+
+				pr = nr
+				cur = r.n
+				if cur == nil {
+					break
+				}
+
+				r = cur.next[i].Load()
+			}
+
+			if cur == nil || !l.less(cur.key, v) {
+				break
+			}
+
+			pred = cur
+			pr = r
+			cur = r.n
+		}
+
+		preds[i] = pred
+		prefs[i] = pr
+		succs[i] = cur
+	}
+
+	return cur != nil && !l.less(v, cur.key)
+}
@@ -0,0 +1,242 @@
+package skiplist
+
+import (
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentPutGet(t *testing.T) {
+	l := NewConcurrent(IntLess)
+
+	for _, v := range []int{1, 5, 9, 3, 7, 0} {
+		assert.True(t, l.Put(v))
+	}
+
+	for _, v := range []int{1, 5, 9, 3, 7, 0} {
+		assert.False(t, l.Put(v))
+	}
+
+	assert.Equal(t, 6, l.Len())
+	assert.Equal(t, []int{0, 1, 3, 5, 7, 9}, slices.Collect(l.All()))
+
+	for _, v := range []int{1, 5, 9, 3, 7, 0} {
+		g, ok := l.Get(v)
+		assert.True(t, ok)
+		assert.Equal(t, v, g)
+	}
+
+	for _, v := range []int{-1, 2, 4, 6, 8, 10} {
+		_, ok := l.Get(v)
+		assert.False(t, ok)
+	}
+
+	g, added := l.GetOrPut(3)
+	assert.False(t, added)
+	assert.Equal(t, 3, g)
+
+	g, added = l.GetOrPut(4)
+	assert.True(t, added)
+	assert.Equal(t, 4, g)
+
+	d, ok := l.Del(3)
+	assert.True(t, ok)
+	assert.Equal(t, 3, d)
+
+	_, ok = l.Del(3)
+	assert.False(t, ok)
+
+	assert.Equal(t, 6, l.Len())
+	assert.Equal(t, []int{0, 1, 4, 5, 7, 9}, slices.Collect(l.All()))
+
+	for range l.All() {
+		break
+	}
+}
+
+func TestConcurrentOverwrite(t *testing.T) {
+	type kv struct {
+		k, v int
+	}
+
+	l := NewConcurrent(func(a, b kv) bool { return a.k < b.k })
+
+	l.Put(kv{k: 1, v: 1})
+	l.Put(kv{k: 1, v: 2})
+
+	g, _ := l.Get(kv{k: 1})
+	assert.Equal(t, 2, g.v)
+
+	g, _ = l.GetOrPut(kv{k: 1, v: 3})
+	assert.Equal(t, 2, g.v)
+}
+
+func TestConcurrentRandom(t *testing.T) {
+	const G = 8
+	const M = 4000
+
+	l := NewConcurrent(IntLess)
+
+	var wg sync.WaitGroup
+	res := make([]map[int]struct{}, G)
+
+	for g := 0; g < G; g++ {
+		wg.Add(1)
+
+		go func(g int) {
+			defer wg.Done()
+
+			rnd := rand.New(rand.NewSource(int64(g)))
+			own := make(map[int]struct{})
+
+			for i := 0; i < M; i++ {
+				v := rnd.Intn(M)*G + g // own keys
+
+				switch rnd.Intn(3) {
+				case 0:
+					l.Del(v)
+					delete(own, v)
+				default:
+					l.Put(v)
+					own[v] = struct{}{}
+				}
+
+				l.Get(rnd.Intn(M * G))   // shared reads
+				l.Del(M*G + rnd.Intn(M)) // shared writes out of own range
+				l.GetOrPut(M*G + rnd.Intn(M))
+			}
+
+			res[g] = own
+		}(g)
+	}
+
+	wg.Wait()
+
+	var exp []int
+	for _, own := range res {
+		for v := range own {
+			exp = append(exp, v)
+		}
+	}
+
+	for v := range l.All() {
+		if v >= M*G {
+			l.Del(v)
+		}
+	}
+
+	slices.Sort(exp)
+
+	assert.Equal(t, exp, slices.Collect(l.All()))
+	assert.Equal(t, len(exp), l.Len())
+
+	for _, v := range exp {
+		_, ok := l.Get(v)
+		assert.True(t, ok, "get %v", v)
+	}
+}
+
+func TestConcurrentSameKeys(t *testing.T) {
+	const G = 8
+	const M = 2000
+	const K = 50
+
+	l := NewConcurrent(IntLess)
+
+	var wg sync.WaitGroup
+
+	for g := 0; g < G; g++ {
+		wg.Add(1)
+
+		go func(g int) {
+			defer wg.Done()
+
+			rnd := rand.New(rand.NewSource(int64(g)))
+
+			for i := 0; i < M; i++ {
+				v := rnd.Intn(K)
+
+				switch rnd.Intn(4) {
+				case 0:
+					l.Del(v)
+				case 1:
+					l.GetOrPut(v)
+				case 2:
+					l.Get(v)
+				default:
+					l.Put(v)
+				}
+			}
+		}(g)
+	}
+
+	wg.Wait()
+
+	vals := slices.Collect(l.All())
+
+	assert.True(t, slices.IsSorted(vals))
+	assert.Equal(t, len(vals), len(slices.Compact(slices.Clone(vals))), "duplicates")
+	assert.Equal(t, len(vals), l.Len())
+}
+
+func TestConcurrentPutDelCount(t *testing.T) {
+	const G = 8
+	const M = 5000
+	const K = 4
+
+	l := NewConcurrent(IntLess)
+
+	var added, deleted [G][K]int
+	var wg sync.WaitGroup
+
+	for g := 0; g < G; g++ {
+		wg.Add(1)
+
+		go func(g int) {
+			defer wg.Done()
+
+			rnd := rand.New(rand.NewSource(int64(g)))
+
+			for i := 0; i < M; i++ {
+				v := rnd.Intn(K)
+
+				if rnd.Intn(2) == 0 {
+					if _, ok := l.Del(v); ok {
+						deleted[g][v]++
+					}
+				} else if l.Put(v) {
+					added[g][v]++
+				}
+			}
+		}(g)
+	}
+
+	wg.Wait()
+
+	for v := 0; v < K; v++ {
+		n := 0
+		for g := 0; g < G; g++ {
+			n += added[g][v] - deleted[g][v]
+		}
+
+		_, ok := l.Get(v)
+
+		exp := 0
+		if ok {
+			exp = 1
+		}
+
+		assert.Equal(t, exp, n, "key %d", v)
+	}
+}
+
+func TestConcurrentOptions(t *testing.T) {
+	l := NewConcurrent(IntLess, WithMaxHeight(100), WithP(0.25), WithSeed(1))
+	assert.Equal(t, concurrentMaxHeight, l.maxh)
+
+	assert.Panics(t, func() { NewConcurrent(IntLess, WithRepeat(true)) })
+	assert.Panics(t, func() { NewConcurrent(IntLess, WithMetrics(Metrics{Put: func(int) {}})) })
+}
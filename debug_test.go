@@ -0,0 +1,33 @@
+//go:build skiplistdebug
+
+package skiplist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDebugPoison(t *testing.T) {
+	l := New(IntLess)
+
+	e, _ := l.Put(1)
+	l.Put(2)
+
+	l.Del(1)
+	l.Release(e)
+
+	assert.Panics(t, func() { e.Value() })
+	assert.Panics(t, func() { e.Next() })
+	assert.Panics(t, func() { l.Prev(e) })
+	assert.Panics(t, func() { l.DelEl(e) })
+	assert.Panics(t, func() { l.SearchFrom(e, 2) })
+	assert.Panics(t, func() { l.Release(e) })
+
+	l.SetAutoReuse(true)
+
+	f := l.First()
+	l.Del(2)
+
+	assert.Panics(t, func() { f.Value() })
+}
@@ -0,0 +1,69 @@
+package skiplist
+
+// DelRange deletes all elements in [lo, hi) range in O(log n + k).
+// f, if not nil, is called for each deleted element in order before it's reused.
+// It returns number of deleted elements.
+func (l *List[T]) DelRange(lo, hi T, f func(*El[T])) int {
+	l.find(lo, true, true)
+
+	k := 0
+	for e := l.up[0].Next(); e != nil && l.less(e.val, hi); e = e.Next() {
+		k++
+	}
+
+	l.cut(k, f)
+
+	return k
+}
+
+// cut deletes k elements following l.up elements.
+func (l *List[T]) cut(k int, f func(*El[T])) {
+	if k == 0 {
+		return
+	}
+
+	first := l.up[0].Next()
+	end := l.pos[0] + k // last deleted position
+
+	for i := 0; i < l.zero.height(); i++ {
+		p, ppos := l.up[i], l.pos[i]
+
+		n := p.nexti(i)
+		if n == nil {
+			break
+		}
+
+		npos := ppos + n.link(i).dist
+		for npos <= end {
+			n = n.nexti(i)
+			if n == nil {
+				break
+			}
+
+			npos += n.link(i).dist
+		}
+
+		p.link(i).next = n
+
+		if n != nil {
+			n.link(i).dist = npos - k - ppos
+		}
+
+		l.setBack(n, i, p)
+	}
+
+	l.len -= k
+
+	e := first
+	for j := 0; j < k; j++ {
+		next := e.Next()
+
+		if f != nil {
+			f(e)
+		}
+
+		l.free(e)
+
+		e = next
+	}
+}
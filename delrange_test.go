@@ -0,0 +1,76 @@
+package skiplist
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelRange(t *testing.T) {
+	l := New(IntLess)
+
+	for i := 0; i < 10; i++ {
+		l.Put(i)
+	}
+
+	var del []int
+	n := l.DelRange(3, 7, func(e *El[int]) {
+		del = append(del, e.Value())
+	})
+
+	assert.Equal(t, 4, n)
+	assert.Equal(t, []int{3, 4, 5, 6}, del)
+	assert.Equal(t, []int{0, 1, 2, 7, 8, 9}, slices.Collect(l.All()))
+	assert.Equal(t, 6, l.Len())
+	checkDist(t, l)
+
+	assert.Equal(t, 0, l.DelRange(3, 7, nil))
+	assert.Equal(t, 0, l.DelRange(7, 3, nil))
+	assert.Equal(t, 3, l.DelRange(7, 100, nil))
+	assert.Equal(t, 3, l.DelRange(-1, 100, nil))
+	assert.Equal(t, 0, l.Len())
+	assert.Nil(t, l.First())
+	checkDist(t, l)
+}
+
+func TestDelRangeRandom(t *testing.T) {
+	const M = 2000
+
+	l := NewRepeated(IntLess, WithSeed(*seed))
+	var exp []int
+
+	for i := 0; i < M; i++ {
+		v := rnd.Intn(M)
+
+		l.Put(v)
+		exp = append(exp, v)
+	}
+
+	slices.Sort(exp)
+
+	for len(exp) != 0 {
+		lo := rnd.Intn(M)
+		hi := lo + rnd.Intn(M/10)
+
+		n := l.DelRange(lo, hi, nil)
+
+		i, _ := slices.BinarySearch(exp, lo)
+		j, _ := slices.BinarySearch(exp, hi)
+		exp = slices.Delete(exp, i, j)
+
+		assert.Equal(t, j-i, n)
+
+		if n != 0 {
+			assert.Equal(t, exp, slices.Collect(l.All()))
+			checkDist(t, l)
+		}
+
+		if rnd.Intn(10) == 0 {
+			l.DelRange(0, M, nil)
+			exp = exp[:0]
+		}
+	}
+
+	assert.Equal(t, 0, l.Len())
+}
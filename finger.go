@@ -0,0 +1,91 @@
+package skiplist
+
+// SearchFrom returns first occurrence of element equal to v or nil if it doesn't exists.
+// Search starts from hint element of the list, so it costs O(log d),
+// where d is the distance from hint to v.
+// If hint is nil or not less than v it's the same as Get.
+func (l *List[T]) SearchFrom(hint *El[T], v T) *El[T] {
+	if hint == nil || !l.less(hint.val, v) {
+		return l.Get(v)
+	}
+
+	cur, _ := l.findFrom(hint, v, true, false)
+
+	cur = cur.Next()
+	if cur == nil || l.less(v, cur.val) {
+		return nil
+	}
+
+	return cur
+}
+
+// PutAfter is the same as Put but search starts from hint element of the list.
+// It costs O(log d) comparisons, where d is the distance from hint to v,
+// unless new element is taller than any passed one, then full search is made.
+// If hint is nil or greater than v it's the same as Put.
+func (l *List[T]) PutAfter(hint *El[T], v T) (*El[T], bool) {
+	if hint == nil || l.less(v, hint.val) {
+		return l.Put(v)
+	}
+
+	cur, top := l.findFrom(hint, v, false, true)
+
+	if !l.repeat && !l.less(cur.val, v) {
+		cur.val = v
+		return cur, false
+	}
+
+	h := l.rndHeight()
+	if h > top {
+		// higher predecessors are behind the hint
+		l.find(v, false, true)
+		top = l.zero.height()
+	}
+
+	return l.insert(v, h, top), true
+}
+
+// findFrom is find started from hint, which must be before v.
+// It climbs up by taller elements and then goes down the usual way.
+// If upd is set l.up and l.pos (relative to hint) are filled below top level.
+func (l *List[T]) findFrom(hint *El[T], v T, first, upd bool) (cur *El[T], top int) {
+	if debug {
+		hint.check()
+	}
+
+	cur = hint
+	pos := 0
+
+	for {
+		i := cur.height() - 1
+
+		n := cur.nexti(i)
+		if n == nil || first && !l.less(n.val, v) || !first && l.less(v, n.val) {
+			break
+		}
+
+		pos += n.link(i).dist
+		cur = n
+	}
+
+	top = cur.height()
+
+	for i := top - 1; i >= 0; i-- {
+		for {
+			n := cur.nexti(i)
+			if n == nil || first && !l.less(n.val, v) || !first && l.less(v, n.val) {
+				break
+			}
+
+			pos += n.link(i).dist
+			cur = n
+		}
+
+		if upd {
+			l.up[i] = cur
+			l.pos[i] = pos
+		}
+	}
+
+	return cur, top
+}
@@ -0,0 +1,77 @@
+package skiplist
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchFrom(t *testing.T) {
+	const N = 1000
+
+	l := New(IntLess, WithSeed(*seed))
+
+	for i := 0; i < N; i++ {
+		l.Put(i * 2)
+	}
+
+	for i := 0; i < N; i++ {
+		hint := l.At(rnd.Intn(N))
+		v := rnd.Intn(2 * N)
+
+		assert.True(t, l.Get(v) == l.SearchFrom(hint, v), "hint %v, v %v", hint.Value(), v)
+	}
+
+	assert.True(t, l.Get(10) == l.SearchFrom(nil, 10))
+}
+
+func TestPutAfter(t *testing.T) {
+	const N = 3000
+
+	var calls int
+	l := NewRepeated(func(a, b int) bool {
+		calls++
+		return a < b
+	}, WithSeed(*seed))
+
+	var exp []int
+	var hint *El[int]
+
+	for i := 0; i < N; i++ {
+		v := i + rnd.Intn(10)
+
+		hint, _ = l.PutAfter(hint, v)
+		exp = append(exp, v)
+	}
+
+	t.Logf("less calls: %d for %d elements", calls, N)
+
+	for i := 0; i < N; i++ {
+		v := rnd.Intn(N)
+
+		hint, _ = l.PutAfter(l.At(rnd.Intn(l.Len())), v)
+		exp = append(exp, v)
+
+		assert.Equal(t, v, hint.Value())
+	}
+
+	slices.Sort(exp)
+
+	assert.Equal(t, exp, slices.Collect(l.All()))
+	checkDist(t, l)
+
+	u := New(IntLess)
+
+	e, added := u.PutAfter(nil, 5)
+	assert.True(t, added)
+
+	e2, added := u.PutAfter(e, 5)
+	assert.False(t, added)
+	assert.True(t, e == e2)
+
+	_, added = u.PutAfter(e, 3)
+	assert.True(t, added)
+
+	assert.Equal(t, []int{3, 5}, slices.Collect(u.All()))
+}
@@ -0,0 +1,67 @@
+package skiplist
+
+// At returns i-th (0-based) element or nil if i is out of range.
+func (l *List[T]) At(i int) *El[T] {
+	if i < 0 || i >= l.len {
+		return nil
+	}
+
+	return l.findPos(i+1, false).Next()
+}
+
+// Rank returns number of elements less than v.
+// That is the index v would be inserted at by PutBefore.
+func (l *List[T]) Rank(v T) int {
+	_, pos, _ := l.find(v, true, false)
+
+	return pos
+}
+
+// CountRange returns number of elements in [lo, hi) range.
+func (l *List[T]) CountRange(lo, hi T) int {
+	n := l.Rank(hi) - l.Rank(lo)
+	if n < 0 {
+		return 0
+	}
+
+	return n
+}
+
+// DelAt deletes i-th (0-based) element and returns its value.
+// It needs no comparisons.
+// Second returned argument is false if i is out of range.
+func (l *List[T]) DelAt(i int) (v T, ok bool) {
+	if i < 0 || i >= l.len {
+		return
+	}
+
+	cur := l.findPos(i+1, true).Next()
+
+	return l.del(cur, l.zero.height()), true
+}
+
+// findPos returns the last element before pos (1-based).
+// If upd is set l.up and l.pos are filled the same way find does.
+func (l *List[T]) findPos(pos int, upd bool) *El[T] {
+	cur := &l.zero
+	p := 0
+
+	for i := cur.height() - 1; i >= 0; i-- {
+		for {
+			n := cur.nexti(i)
+			if n == nil || p+n.link(i).dist >= pos {
+				break
+			}
+
+			p += n.link(i).dist
+			cur = n
+		}
+
+		if upd {
+			l.up[i] = cur
+			l.pos[i] = p
+		}
+	}
+
+	return cur
+}
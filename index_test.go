@@ -0,0 +1,142 @@
+package skiplist
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndex(t *testing.T) {
+	l := New(IntLess)
+
+	for _, v := range []int{50, 10, 30, 20, 40} {
+		l.Put(v)
+	}
+
+	for i, v := range []int{10, 20, 30, 40, 50} {
+		assert.Equal(t, v, l.At(i).Value(), "at %d", i)
+		assert.Equal(t, i, l.Rank(v))
+		assert.Equal(t, i+1, l.Rank(v+1))
+	}
+
+	assert.Nil(t, l.At(-1))
+	assert.Nil(t, l.At(5))
+
+	assert.Equal(t, 2, l.CountRange(20, 40))
+	assert.Equal(t, 3, l.CountRange(15, 45))
+	assert.Equal(t, 0, l.CountRange(45, 15))
+	assert.Equal(t, 5, l.CountRange(0, 100))
+
+	v, ok := l.DelAt(2)
+	assert.True(t, ok)
+	assert.Equal(t, 30, v)
+
+	_, ok = l.DelAt(4)
+	assert.False(t, ok)
+	assert.Equal(t, 4, l.Len())
+	assert.Equal(t, 40, l.At(2).Value())
+
+	checkDist(t, l)
+}
+
+func TestIndexRandom(t *testing.T) {
+	const M = 3000
+
+	type iv struct{ v, id int }
+
+	l := NewRepeated(func(a, b iv) bool { return a.v < b.v }, WithSeed(*seed))
+	var exp []iv
+
+	// first index with value not less than v if before, greater than v overwise
+	bound := func(v int, before bool) int {
+		i, _ := slices.BinarySearchFunc(exp, v, func(x iv, v int) int {
+			if x.v < v || !before && x.v == v {
+				return -1
+			}
+
+			return 1
+		})
+
+		return i
+	}
+
+	for i := 0; i < M; i++ {
+		x := iv{v: rnd.Intn(M / 3), id: i}
+
+		switch rnd.Intn(4) {
+		case 0:
+			l.PutBefore(x)
+			exp = slices.Insert(exp, bound(x.v, true), x)
+		case 1:
+			got, ok := l.Del(x)
+
+			j := bound(x.v, true)
+			if !assert.Equal(t, j < len(exp) && exp[j].v == x.v, ok, "del %v", x.v) || !ok {
+				break
+			}
+
+			assert.Equal(t, exp[j], got)
+			exp = slices.Delete(exp, j, j+1)
+		case 2:
+			if len(exp) == 0 {
+				break
+			}
+
+			j := rnd.Intn(len(exp))
+
+			got, ok := l.DelAt(j)
+			assert.True(t, ok)
+			assert.Equal(t, exp[j], got, "del at %d", j)
+
+			exp = slices.Delete(exp, j, j+1)
+		default:
+			l.Put(x)
+			exp = slices.Insert(exp, bound(x.v, false), x)
+		}
+	}
+
+	checkDist(t, l)
+
+	assert.Equal(t, exp, slices.Collect(l.All()))
+
+	for i, x := range exp {
+		if e := l.At(i); e == nil || e.Value() != x {
+			t.Errorf("at %d: %v, want %v", i, e, x)
+		}
+	}
+
+	for v := 0; v < M/3; v++ {
+		assert.Equal(t, bound(v, true), l.Rank(iv{v: v}), "rank of %v", v)
+	}
+}
+
+func checkDist[T any](t *testing.T, l *List[T]) {
+	t.Helper()
+
+	pos := make(map[*El[T]]int, l.Len())
+	i := 0
+	for e := l.First(); e != nil; e = e.Next() {
+		i++
+		pos[e] = i
+	}
+
+	assert.Equal(t, l.Len(), i)
+
+	for lvl := 0; lvl < l.zero.height(); lvl++ {
+		prev := 0
+		p := &l.zero
+		for e := l.zero.nexti(lvl); e != nil; e = e.nexti(lvl) {
+			if d := e.link(lvl).dist; d != pos[e]-prev {
+				t.Errorf("level %d: pos %d: dist %d, want %d", lvl, pos[e], d, pos[e]-prev)
+			}
+
+			if l.backlinks && e.backi(lvl) != p {
+				t.Errorf("level %d: pos %d: wrong back link", lvl, pos[e])
+			}
+
+			prev = pos[e]
+			p = e
+		}
+	}
+}
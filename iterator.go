@@ -0,0 +1,190 @@
+package skiplist
+
+type (
+	// Iterator walks over list elements in order, optionally restricted by lower and upper bounds.
+	// It must not be used after the list was modified.
+	Iterator[T any] struct {
+		l *List[T]
+		e *El[T]
+
+		lo, hi   T
+		lob, hib bound
+	}
+
+	bound uint8
+)
+
+const (
+	unbounded bound = iota
+	inclusive
+	exclusive
+)
+
+// Iter returns unbounded Iterator. Call First or Seek to position it.
+func (l *List[T]) Iter() *Iterator[T] {
+	return &Iterator[T]{l: l}
+}
+
+// IterRange returns Iterator over [lo, hi) range. Call First or Seek to position it.
+func (l *List[T]) IterRange(lo, hi T) *Iterator[T] {
+	it := l.Iter()
+	it.SetLower(lo, true)
+	it.SetUpper(hi, false)
+
+	return it
+}
+
+// SetLower sets lower bound.
+func (it *Iterator[T]) SetLower(v T, incl bool) {
+	it.lo = v
+	it.lob = mkbound(incl)
+}
+
+// SetUpper sets upper bound.
+func (it *Iterator[T]) SetUpper(v T, incl bool) {
+	it.hi = v
+	it.hib = mkbound(incl)
+}
+
+// First positions iterator at the first element within bounds.
+func (it *Iterator[T]) First() bool {
+	switch it.lob {
+	case inclusive:
+		it.e, _ = it.l.search(it.lo, true, false)
+	case exclusive:
+		e, _, _ := it.l.find(it.lo, false, false)
+		it.e = e.Next()
+	default:
+		it.e = it.l.First()
+	}
+
+	return it.check()
+}
+
+// Seek positions iterator at the first element not less than v.
+func (it *Iterator[T]) Seek(v T) bool {
+	if !it.aboveLower(v) {
+		return it.First()
+	}
+
+	it.e, _ = it.l.search(v, true, false)
+
+	return it.check()
+}
+
+// Last positions iterator at the last element within bounds.
+func (it *Iterator[T]) Last() bool {
+	switch it.hib {
+	case inclusive:
+		it.e, _, _ = it.l.find(it.hi, false, false)
+	case exclusive:
+		it.e, _, _ = it.l.find(it.hi, true, false)
+	default:
+		it.e = it.l.Last()
+	}
+
+	return it.checkzero()
+}
+
+// SeekLT positions iterator at the last element less than v.
+func (it *Iterator[T]) SeekLT(v T) bool {
+	if !it.belowUpper(v) {
+		return it.Last()
+	}
+
+	it.e, _, _ = it.l.find(v, true, false)
+
+	return it.checkzero()
+}
+
+// Next moves iterator to the next element.
+func (it *Iterator[T]) Next() bool {
+	if it.e == nil {
+		return false
+	}
+
+	it.e = it.e.Next()
+
+	if it.e != nil && !it.belowUpper(it.e.val) {
+		it.e = nil
+	}
+
+	return it.e != nil
+}
+
+// Prev moves iterator to the previous element.
+// It costs a search unless list has back links.
+func (it *Iterator[T]) Prev() bool {
+	if it.e == nil {
+		return false
+	}
+
+	it.e = it.l.Prev(it.e)
+
+	if it.e != nil && !it.aboveLower(it.e.val) {
+		it.e = nil
+	}
+
+	return it.e != nil
+}
+
+// Valid reports whether iterator is positioned at an element.
+func (it *Iterator[T]) Valid() bool {
+	return it.e != nil
+}
+
+// Value returns current element value. Iterator must be Valid.
+func (it *Iterator[T]) Value() T {
+	return it.e.val
+}
+
+// El returns current element or nil.
+func (it *Iterator[T]) El() *El[T] {
+	return it.e
+}
+
+func (it *Iterator[T]) check() bool {
+	if it.e != nil && (!it.aboveLower(it.e.val) || !it.belowUpper(it.e.val)) {
+		it.e = nil
+	}
+
+	return it.e != nil
+}
+
+func (it *Iterator[T]) checkzero() bool {
+	if it.e == &it.l.zero {
+		it.e = nil
+	}
+
+	return it.check()
+}
+
+func (it *Iterator[T]) aboveLower(v T) bool {
+	switch it.lob {
+	case inclusive:
+		return !it.l.less(v, it.lo)
+	case exclusive:
+		return it.l.less(it.lo, v)
+	default:
+		return true
+	}
+}
+
+func (it *Iterator[T]) belowUpper(v T) bool {
+	switch it.hib {
+	case inclusive:
+		return !it.l.less(it.hi, v)
+	case exclusive:
+		return it.l.less(v, it.hi)
+	default:
+		return true
+	}
+}
+
+func mkbound(incl bool) bound {
+	if incl {
+		return inclusive
+	}
+
+	return exclusive
+}
@@ -0,0 +1,142 @@
+package skiplist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func collect[T any](it *Iterator[T], ok bool) (r []T) {
+	for ; ok; ok = it.Next() {
+		r = append(r, it.Value())
+	}
+
+	return r
+}
+
+func TestIterator(t *testing.T) {
+	l := New(IntLess)
+
+	for i := 0; i < 10; i++ {
+		l.Put(i * 2)
+	}
+
+	it := l.Iter()
+
+	assert.False(t, it.Valid())
+	assert.Nil(t, it.El())
+
+	assert.Equal(t, []int{0, 2, 4, 6, 8, 10, 12, 14, 16, 18}, collect(it, it.First()))
+	assert.False(t, it.Next())
+
+	assert.Equal(t, []int{6, 8, 10, 12, 14, 16, 18}, collect(it, it.Seek(5)))
+	assert.Equal(t, []int{6, 8, 10, 12, 14, 16, 18}, collect(it, it.Seek(6)))
+	assert.Equal(t, []int(nil), collect(it, it.Seek(19)))
+
+	assert.Equal(t, []int{4, 6, 8, 10, 12, 14, 16, 18}, collect(it, it.SeekLT(5)))
+	assert.Equal(t, []int{4, 6, 8, 10, 12, 14, 16, 18}, collect(it, it.SeekLT(6)))
+	assert.Equal(t, []int(nil), collect(it, it.SeekLT(0)))
+	assert.Equal(t, []int{18}, collect(it, it.SeekLT(100)))
+}
+
+func TestIteratorBounds(t *testing.T) {
+	l := New(IntLess)
+
+	for i := 0; i < 10; i++ {
+		l.Put(i * 2)
+	}
+
+	it := l.IterRange(4, 10)
+
+	assert.Equal(t, []int{4, 6, 8}, collect(it, it.First()))
+	assert.Equal(t, []int{4, 6, 8}, collect(it, it.Seek(0)))
+	assert.Equal(t, []int{6, 8}, collect(it, it.Seek(5)))
+	assert.Equal(t, []int(nil), collect(it, it.Seek(10)))
+	assert.Equal(t, []int{8}, collect(it, it.SeekLT(100)))
+	assert.Equal(t, []int{4, 6, 8}, collect(it, it.SeekLT(5)))
+	assert.Equal(t, []int(nil), collect(it, it.SeekLT(4)))
+
+	it.SetLower(4, false)
+	it.SetUpper(10, true)
+
+	assert.Equal(t, []int{6, 8, 10}, collect(it, it.First()))
+	assert.Equal(t, []int{6, 8, 10}, collect(it, it.Seek(4)))
+	assert.Equal(t, []int{10}, collect(it, it.SeekLT(100)))
+	assert.Equal(t, []int{8, 10}, collect(it, it.SeekLT(10)))
+
+	it.SetLower(5, false)
+	it.SetUpper(5, true)
+
+	assert.Equal(t, []int(nil), collect(it, it.First()))
+}
+
+func TestIteratorRepeated(t *testing.T) {
+	l := NewRepeated(IntLess)
+
+	for _, v := range []int{1, 2, 2, 2, 3, 3, 4} {
+		l.Put(v)
+	}
+
+	it := l.IterRange(2, 4)
+
+	assert.Equal(t, []int{2, 2, 2, 3, 3}, collect(it, it.First()))
+	assert.Equal(t, []int{3, 3}, collect(it, it.Seek(3)))
+	assert.Equal(t, []int{2, 3, 3}, collect(it, it.SeekLT(3)))
+
+	it.SetLower(2, false)
+
+	assert.Equal(t, []int{3, 3}, collect(it, it.First()))
+}
+
+func collectBack[T any](it *Iterator[T], ok bool) (r []T) {
+	for ; ok; ok = it.Prev() {
+		r = append(r, it.Value())
+	}
+
+	return r
+}
+
+func TestIteratorBackward(t *testing.T) {
+	l := New(IntLess)
+
+	it := l.Iter()
+	assert.False(t, it.Last())
+	assert.False(t, it.Prev())
+
+	for i := 0; i < 10; i++ {
+		l.Put(i * 2)
+	}
+
+	assert.Equal(t, []int{18, 16, 14, 12, 10, 8, 6, 4, 2, 0}, collectBack(it, it.Last()))
+	assert.Equal(t, []int{4, 2, 0}, collectBack(it, it.SeekLT(5)))
+	assert.Equal(t, []int{6, 4, 2, 0}, collectBack(it, it.Seek(5)))
+
+	it = l.IterRange(4, 10)
+
+	assert.Equal(t, []int{8, 6, 4}, collectBack(it, it.Last()))
+	assert.Equal(t, []int{8, 6, 4}, collectBack(it, it.SeekLT(10)))
+	assert.Equal(t, []int{6, 4}, collectBack(it, it.SeekLT(8)))
+
+	it.SetLower(4, false)
+	it.SetUpper(10, true)
+
+	assert.Equal(t, []int{10, 8, 6}, collectBack(it, it.Last()))
+	assert.Equal(t, []int{10, 8, 6}, collectBack(it, it.SeekLT(11)))
+}
+
+func TestIteratorBackwardRepeated(t *testing.T) {
+	l := NewRepeated(IntLess)
+
+	var els []*El[int]
+	for _, v := range []int{1, 2, 2, 2, 3} {
+		e, _ := l.Put(v)
+		els = append(els, e)
+	}
+
+	for i := len(els) - 1; i > 0; i-- {
+		assert.True(t, l.Prev(els[i]) == els[i-1], "prev of %d", i)
+	}
+
+	assert.Nil(t, l.Prev(els[0]))
+	assert.True(t, l.Last() == els[len(els)-1])
+}
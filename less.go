@@ -1,40 +1,45 @@
 package skiplist
 
+import "cmp"
+
+// Compare calls less once or twice to make three-way comparison.
+func (less LessFunc[T]) Compare(a, b T) int {
+	switch {
+	case less(a, b):
+		return -1
+	case less(b, a):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Less reports whether a is less than b.
+func (compare CompareFunc[T]) Less(a, b T) bool {
+	return compare(a, b) < 0
+}
+
+// Less is a LessFunc for any ordered type.
+func Less[T cmp.Ordered](a, b T) bool {
+	return a < b
+}
+
+// Greater is a LessFunc for any ordered type with reversed order.
+func Greater[T cmp.Ordered](a, b T) bool {
+	return a > b
+}
+
 var (
-	IntLess LessFunc = func(a, b interface{}) bool {
-		return a.(int) < b.(int)
-	}
-	IntGreater LessFunc = func(a, b interface{}) bool {
-		return a.(int) > b.(int)
-	}
-	Int64Less LessFunc = func(a, b interface{}) bool {
-		return a.(int64) < b.(int64)
-	}
-	Int64Greater LessFunc = func(a, b interface{}) bool {
-		return a.(int64) > b.(int64)
-	}
-	Uint64Less LessFunc = func(a, b interface{}) bool {
-		return a.(uint64) < b.(uint64)
-	}
-	Uint64Greater LessFunc = func(a, b interface{}) bool {
-		return a.(uint64) > b.(uint64)
-	}
-	Int32Less LessFunc = func(a, b interface{}) bool {
-		return a.(int32) < b.(int32)
-	}
-	Int32Greater LessFunc = func(a, b interface{}) bool {
-		return a.(int32) > b.(int32)
-	}
-	Uint32Less LessFunc = func(a, b interface{}) bool {
-		return a.(uint32) < b.(uint32)
-	}
-	Uint32Greater LessFunc = func(a, b interface{}) bool {
-		return a.(uint32) > b.(uint32)
-	}
-	StringLess LessFunc = func(a, b interface{}) bool {
-		return a.(string) < b.(string)
-	}
-	StringGreater LessFunc = func(a, b interface{}) bool {
-		return a.(string) > b.(string)
-	}
+	IntLess       LessFunc[int]    = Less[int]
+	IntGreater    LessFunc[int]    = Greater[int]
+	Int64Less     LessFunc[int64]  = Less[int64]
+	Int64Greater  LessFunc[int64]  = Greater[int64]
+	Uint64Less    LessFunc[uint64] = Less[uint64]
+	Uint64Greater LessFunc[uint64] = Greater[uint64]
+	Int32Less     LessFunc[int32]  = Less[int32]
+	Int32Greater  LessFunc[int32]  = Greater[int32]
+	Uint32Less    LessFunc[uint32] = Less[uint32]
+	Uint32Greater LessFunc[uint32] = Greater[uint32]
+	StringLess    LessFunc[string] = Less[string]
+	StringGreater LessFunc[string] = Greater[string]
 )
@@ -25,3 +25,21 @@ func TestCoverLessFuncs(t *testing.T) {
 	assert.True(t, StringLess("1", "2"))
 	assert.True(t, StringGreater("2", "1"))
 }
+
+func TestCoverGenericLessFuncs(t *testing.T) {
+	assert.True(t, Less(1.5, 2.5))
+	assert.True(t, Greater(2.5, 1.5))
+	assert.False(t, Less("b", "a"))
+	assert.False(t, Greater(uint8(1), uint8(1)))
+}
+
+func TestCompareAdapters(t *testing.T) {
+	assert.Equal(t, -1, IntLess.Compare(1, 2))
+	assert.Equal(t, 1, IntLess.Compare(2, 1))
+	assert.Equal(t, 0, IntLess.Compare(2, 2))
+
+	cmp := CompareFunc[int](func(a, b int) int { return a - b })
+
+	assert.True(t, cmp.Less(1, 2))
+	assert.False(t, cmp.Less(2, 2))
+}
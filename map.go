@@ -0,0 +1,106 @@
+package skiplist
+
+type (
+	// Map is an ordered key-value map built on skiplist.
+	// Keys are unique.
+	Map[K, V any] struct {
+		l *List[entry[K, V]]
+	}
+
+	entry[K, V any] struct {
+		k K
+		v V
+	}
+)
+
+// NewMap creates Map ordered by less applied to keys
+func NewMap[K, V any](less LessFunc[K]) *Map[K, V] {
+	return &Map[K, V]{
+		l: New(func(a, b entry[K, V]) bool {
+			return less(a.k, b.k)
+		}, WithAutoReuse(true)),
+	}
+}
+
+// NewMapCompare creates Map ordered by cmp applied to keys
+func NewMapCompare[K, V any](cmp CompareFunc[K]) *Map[K, V] {
+	return &Map[K, V]{
+		l: NewCompare(func(a, b entry[K, V]) int {
+			return cmp(a.k, b.k)
+		}, WithAutoReuse(true)),
+	}
+}
+
+// Len returns number of keys
+func (m *Map[K, V]) Len() int {
+	return m.l.Len()
+}
+
+// Get returns value stored by key k.
+// Second returned argument is false if there is no such key.
+func (m *Map[K, V]) Get(k K) (v V, ok bool) {
+	e := m.l.Get(entry[K, V]{k: k})
+	if e == nil {
+		return
+	}
+
+	return e.val.v, true
+}
+
+// Set sets value for key k. Existing value is overwritten in place.
+func (m *Map[K, V]) Set(k K, v V) {
+	m.l.Put(entry[K, V]{k: k, v: v})
+}
+
+// Delete deletes key k and returns its value.
+// Second returned argument is false if there was no such key.
+func (m *Map[K, V]) Delete(k K) (v V, ok bool) {
+	e, ok := m.l.Del(entry[K, V]{k: k})
+
+	return e.v, ok
+}
+
+// Swap sets value for key k and returns the previous one.
+// loaded is false if there was no such key.
+func (m *Map[K, V]) Swap(k K, v V) (prev V, loaded bool) {
+	e, added := m.l.GetOrPut(entry[K, V]{k: k, v: v})
+	if added {
+		return
+	}
+
+	prev = e.val.v
+	e.val.v = v
+
+	return prev, true
+}
+
+// CompareAndSwap sets value for key k to new if it exists and its value is equal to old.
+// It panics if V is not comparable the same way sync.Map does.
+func (m *Map[K, V]) CompareAndSwap(k K, old, new V) bool {
+	e := m.l.Get(entry[K, V]{k: k})
+	if e == nil || any(e.val.v) != any(old) {
+		return false
+	}
+
+	e.val.v = new
+
+	return true
+}
+
+// LoadOrStore returns existing value for key k if present.
+// Otherwise it stores and returns v.
+// loaded is true if value was loaded, false if stored.
+func (m *Map[K, V]) LoadOrStore(k K, v V) (actual V, loaded bool) {
+	e, added := m.l.GetOrPut(entry[K, V]{k: k, v: v})
+
+	return e.val.v, !added
+}
+
+// Range calls f for each key-value pair in order until f returns false.
+func (m *Map[K, V]) Range(f func(k K, v V) bool) {
+	for e := m.l.First(); e != nil; e = e.Next() {
+		if !f(e.val.k, e.val.v) {
+			return
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package skiplist
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap(t *testing.T) {
+	m := NewMap[int, string](IntLess)
+
+	for _, k := range []int{5, 1, 9, 3, 7} {
+		m.Set(k, "v")
+	}
+
+	assert.Equal(t, 5, m.Len())
+
+	m.Set(3, "three")
+
+	assert.Equal(t, 5, m.Len())
+
+	v, ok := m.Get(3)
+	assert.True(t, ok)
+	assert.Equal(t, "three", v)
+
+	_, ok = m.Get(4)
+	assert.False(t, ok)
+
+	v, ok = m.Delete(3)
+	assert.True(t, ok)
+	assert.Equal(t, "three", v)
+
+	_, ok = m.Delete(3)
+	assert.False(t, ok)
+
+	assert.Equal(t, 4, m.Len())
+
+	var keys []int
+	m.Range(func(k int, v string) bool {
+		keys = append(keys, k)
+		return k < 7
+	})
+	assert.Equal(t, []int{1, 5, 7}, keys)
+}
+
+func TestMapSwap(t *testing.T) {
+	m := NewMap[string, int](StringLess)
+
+	prev, loaded := m.Swap("a", 1)
+	assert.False(t, loaded)
+	assert.Equal(t, 0, prev)
+
+	prev, loaded = m.Swap("a", 2)
+	assert.True(t, loaded)
+	assert.Equal(t, 1, prev)
+
+	assert.False(t, m.CompareAndSwap("a", 1, 3))
+	assert.True(t, m.CompareAndSwap("a", 2, 3))
+	assert.False(t, m.CompareAndSwap("b", 0, 3))
+
+	v, _ := m.Get("a")
+	assert.Equal(t, 3, v)
+
+	act, loaded := m.LoadOrStore("a", 4)
+	assert.True(t, loaded)
+	assert.Equal(t, 3, act)
+
+	act, loaded = m.LoadOrStore("b", 5)
+	assert.False(t, loaded)
+	assert.Equal(t, 5, act)
+
+	assert.Equal(t, 2, m.Len())
+}
+
+func TestMapCompare(t *testing.T) {
+	m := NewMapCompare[string, int](strings.Compare)
+
+	m.Set("b", 2)
+	m.Set("a", 1)
+	m.Set("b", 3)
+
+	v, ok := m.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+	assert.Equal(t, 2, m.Len())
+}
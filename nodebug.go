@@ -0,0 +1,5 @@
+//go:build !skiplistdebug
+
+package skiplist
+
+const debug = false
@@ -0,0 +1,232 @@
+package skiplist
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+)
+
+type (
+	// Option configures list.
+	Option func(c *config)
+
+	// splitMix is a cheap rand.Source safe for concurrent use.
+	// It's SplitMix64 with atomic state.
+	splitMix struct {
+		s atomic.Uint64
+	}
+
+	// Metrics hooks are called on list modifications. Nil hooks are skipped.
+	Metrics struct {
+		Put func(height int) // element inserted
+		Del func(height int) // element removed
+	}
+
+	// config is the type independent part of list settings.
+	config struct {
+		heights
+
+		repeat    bool
+		autoreuse bool
+		backlinks bool
+
+		pool   *sync.Pool
+		alloc  any // Allocator[T]
+		layout Layout
+
+		metrics Metrics
+	}
+
+	// heights is the part of settings defining element heights.
+	heights struct {
+		maxh int
+		rnd  rand.Source
+
+		// level probability: bits per level if it's a power of 1/2, threshold for rand.Int63 overwise
+		bits int
+		thr  int64
+	}
+)
+
+// WithRepeat allows repeated elements.
+func WithRepeat(v bool) Option {
+	return func(c *config) {
+		c.repeat = v
+	}
+}
+
+// WithAutoReuse enables or disables auto reuse of deleted elements.
+// Deleted element is given back to the allocator at once,
+// so it must be used only if the caller doesn't keep *El got from the list.
+// It is disabled by default, see List.Release.
+func WithAutoReuse(v bool) Option {
+	return func(c *config) {
+		c.autoreuse = v
+	}
+}
+
+// WithBackLinks makes elements keep links to previous elements at each level.
+// They are kept in the element tower, so Allocator is asked for towers twice as high.
+// It costs a pointer per level and makes DelEl and Prev O(height) without comparisons.
+func WithBackLinks(v bool) Option {
+	return func(c *config) {
+		c.backlinks = v
+	}
+}
+
+// WithMaxHeight sets max tower height. DefaultMaxHeight is used by default.
+func WithMaxHeight(n int) Option {
+	if n < 1 {
+		panic("skiplist: max height must be positive")
+	}
+
+	return func(c *config) {
+		c.maxh = n
+	}
+}
+
+// WithP sets probability of an element to be promoted to the next level. It's 1/2 by default.
+// Powers of 1/2 are the fastest.
+func WithP(p float64) Option {
+	if !(p > 0 && p < 1) {
+		panic("skiplist: p must be in (0, 1)")
+	}
+
+	return func(c *config) {
+		c.bits, c.thr = 0, 0
+
+		if frac, exp := math.Frexp(p); frac == 0.5 && 1-exp < 63 {
+			c.bits = 1 - exp
+		} else {
+			c.thr = int64(p * (1 << 63))
+		}
+	}
+}
+
+// WithRand sets random source for tower heights.
+// By default each list has its own cheap source seeded from global math/rand.
+// ConcurrentList requires src to be safe for concurrent use.
+func WithRand(src rand.Source) Option {
+	return func(c *config) {
+		c.rnd = src
+	}
+}
+
+// WithSeed makes tower heights deterministic.
+// Lists built with the same seed, options and sequence of operations have identical structure.
+func WithSeed(seed int64) Option {
+	return func(c *config) {
+		r := &splitMix{}
+		r.Seed(seed)
+
+		c.rnd = r
+	}
+}
+
+// WithPool sets pool to take elements from and reuse them to.
+// Each list has its own pool by default, lists made of it (by SplitAt, Union and so on) share it.
+func WithPool(p *sync.Pool) Option {
+	return func(c *config) {
+		c.pool = p
+	}
+}
+
+// WithLayout sets element memory layout. FixedLayout is used by default.
+// It's ignored if custom allocator is set.
+func WithLayout(v Layout) Option {
+	return func(c *config) {
+		c.layout = v
+	}
+}
+
+// WithAllocator sets custom element allocator. It overrides WithPool.
+// Allocator element type must match the list one.
+func WithAllocator[T any](a Allocator[T]) Option {
+	return func(c *config) {
+		c.alloc = a
+	}
+}
+
+// WithMetrics sets metrics hooks.
+func WithMetrics(m Metrics) Option {
+	return func(c *config) {
+		c.metrics = m
+	}
+}
+
+func newConfig(opts []Option) config {
+	c := config{
+		heights: heights{
+			maxh: DefaultMaxHeight,
+			bits: 1,
+		},
+	}
+
+	for _, o := range opts {
+		o(&c)
+	}
+
+	if c.rnd == nil {
+		r := &splitMix{}
+		r.Seed(rand.Int63())
+
+		c.rnd = r
+	}
+
+	return c
+}
+
+// newHeights is newConfig for lists taking only height options: WithMaxHeight, WithP, WithRand and WithSeed.
+// It panics if any other option is set.
+func newHeights(opts []Option) heights {
+	c := newConfig(opts)
+
+	if c.repeat || c.autoreuse || c.backlinks || c.pool != nil || c.alloc != nil || c.layout != FixedLayout ||
+		c.metrics.Put != nil || c.metrics.Del != nil {
+		panic("skiplist: only height options are supported")
+	}
+
+	return c.heights
+}
+
+func (c *heights) rndHeight() int {
+	h := 1
+
+	if c.bits == 0 {
+		for h < c.maxh && c.rnd.Int63() < c.thr {
+			h++
+		}
+
+		return h
+	}
+
+	mask := int64(1)<<c.bits - 1
+
+	for h < c.maxh {
+		r := c.rnd.Int63()
+
+		for n := 63 / c.bits; n > 0 && h < c.maxh; n-- {
+			if r&mask != mask {
+				return h
+			}
+
+			h++
+			r >>= c.bits
+		}
+	}
+
+	return h
+}
+
+func (r *splitMix) Seed(seed int64) {
+	r.s.Store(uint64(seed))
+}
+
+func (r *splitMix) Int63() int64 {
+	z := r.s.Add(0x9e3779b97f4a7c15)
+	z = (z ^ z>>30) * 0xbf58476d1ce4e5b9
+	z = (z ^ z>>27) * 0x94d049bb133111eb
+
+	return int64((z ^ z>>31) >> 1)
+}
@@ -0,0 +1,353 @@
+package skiplist
+
+import (
+	"iter"
+	"slices"
+	"sort"
+	"sync/atomic"
+)
+
+type (
+	// PersistentList is a skiplist with O(1) snapshots.
+	// Elements are unique, Put overwrites existing value.
+	//
+	// It's stored as a tree of towers: an element of height h starts a node at each level below h
+	// which holds the following elements of lower levels up to the next element of the same height or taller.
+	// Nodes reachable from a snapshot are never modified, Put and Del copy the path from the root
+	// to the changed place instead. Nodes copied after the last snapshot are modified in place.
+	//
+	// Snapshot is safe to read concurrently with modifications of the list it was taken from.
+	// The list itself is not safe for concurrent use.
+	PersistentList[T any] struct {
+		heights
+
+		less LessFunc[T]
+		root *pnode[T]
+		lvl  int // root level
+		len  int
+		gen  uint64 // nodes of this generation are owned by the list
+	}
+
+	pnode[T any] struct {
+		gen uint64
+
+		vals []T // level 1
+
+		kids []*pnode[T] // upper levels
+		keys []T         // first values of kids, keys[0] is not used
+	}
+)
+
+// pgen is the last persistent list generation given.
+var pgen atomic.Uint64
+
+// NewPersistent creates persistent skiplist without repeated elements.
+// Element heights define the tree shape, that's what options can set.
+// Options not related to heights cause panic.
+func NewPersistent[T any](less LessFunc[T], opts ...Option) *PersistentList[T] {
+	l := &PersistentList[T]{
+		heights: newHeights(opts),
+		less:    less,
+		lvl:     1,
+		gen:     pgen.Add(1),
+	}
+
+	l.root = l.node()
+
+	return l
+}
+
+// Snapshot returns a copy of the list in O(1).
+// Both the list and the snapshot may be modified after that independently,
+// even concurrently. The snapshot gets its own random source seeded from the list one.
+func (l *PersistentList[T]) Snapshot() *PersistentList[T] {
+	s := *l
+	s.gen = pgen.Add(1)
+	l.gen = pgen.Add(1)
+
+	r := &splitMix{}
+	r.Seed(l.rnd.Int63())
+	s.rnd = r
+
+	return &s
+}
+
+// Len returns length of list.
+func (l *PersistentList[T]) Len() int {
+	return l.len
+}
+
+// Get returns value equal to v.
+// Second returned argument is false if it doesn't exist.
+func (l *PersistentList[T]) Get(v T) (T, bool) {
+	n := l.root
+
+	for lvl := l.lvl; lvl > 1; lvl-- {
+		i := l.searchKids(n, v)
+		if i > 0 && !l.less(n.keys[i], v) {
+			return n.keys[i], true
+		}
+
+		n = n.kids[i]
+	}
+
+	i, eq := l.searchVals(n, v)
+	if !eq {
+		var zero T
+		return zero, false
+	}
+
+	return n.vals[i], true
+}
+
+// Put puts new value or overwrites existing.
+// It returns true if there wasn't such element.
+func (l *PersistentList[T]) Put(v T) bool {
+	h := l.rndHeight()
+
+	for l.lvl < h {
+		r := l.node()
+		r.kids = []*pnode[T]{l.root}
+		r.keys = make([]T, 1)
+
+		l.root = r
+		l.lvl++
+	}
+
+	root, _, added := l.put(l.root, l.lvl, v, h)
+
+	l.root = root
+
+	if !added {
+		l.shrink() // v existed, drop levels added for it
+
+		return false
+	}
+
+	l.len++
+
+	return true
+}
+
+// Del deletes element equal to v and returns its value.
+// Second returned argument is false if it wasn't existed.
+func (l *PersistentList[T]) Del(v T) (T, bool) {
+	root, old, ok := l.del(l.root, l.lvl, v)
+	if !ok {
+		var zero T
+		return zero, false
+	}
+
+	l.root = root
+	l.len--
+
+	l.shrink()
+
+	return old, true
+}
+
+// All returns iterator over values in order.
+// The list must not be modified while iterating, iterate a Snapshot for that.
+func (l *PersistentList[T]) All() iter.Seq[T] {
+	root, lvl := l.root, l.lvl
+
+	return func(yield func(T) bool) {
+		root.all(lvl, yield)
+	}
+}
+
+// shrink removes root levels with no elements.
+func (l *PersistentList[T]) shrink() {
+	for l.lvl > 1 && len(l.root.kids) == 1 {
+		l.root = l.root.kids[0]
+		l.lvl--
+	}
+}
+
+// put puts v of height h to the subtree of n of level lvl.
+// If v splits n, which happens if lvl < h, the right part is returned.
+func (l *PersistentList[T]) put(n *pnode[T], lvl int, v T, h int) (left, right *pnode[T], added bool) {
+	if lvl == 1 {
+		i, eq := l.searchVals(n, v)
+
+		switch {
+		case eq:
+			n = l.own(n)
+			n.vals[i] = v
+
+			return n, nil, false
+		case h == 1:
+			n = l.own(n)
+			n.vals = slices.Insert(n.vals, i, v)
+
+			return n, nil, true
+		}
+
+		right = l.node()
+		right.vals = append(append(make([]T, 0, len(n.vals)-i+1), v), n.vals[i:]...)
+
+		n = l.own(n)
+		clear(n.vals[i:])
+		n.vals = n.vals[:i]
+
+		return n, right, true
+	}
+
+	i := l.searchKids(n, v)
+
+	c, r, added := l.put(n.kids[i], lvl-1, v, h)
+
+	if c != n.kids[i] {
+		n = l.own(n)
+		n.kids[i] = c
+	}
+
+	if r == nil {
+		if !added && i > 0 && !l.less(n.keys[i], v) {
+			n = l.own(n)
+			n.keys[i] = v // keep keys the same as overwritten values
+		}
+
+		return n, nil, added
+	}
+
+	n = l.own(n)
+
+	if lvl == h {
+		n.kids = slices.Insert(n.kids, i+1, r)
+		n.keys = slices.Insert(n.keys, i+1, v)
+
+		return n, nil, true
+	}
+
+	right = l.node()
+	right.kids = append(append(make([]*pnode[T], 0, len(n.kids)-i), r), n.kids[i+1:]...)
+	right.keys = append(append(make([]T, 0, len(n.keys)-i), v), n.keys[i+1:]...)
+
+	clear(n.kids[i+1:])
+	clear(n.keys[i+1:])
+	n.kids = n.kids[:i+1]
+	n.keys = n.keys[:i+1]
+
+	return n, right, true
+}
+
+// del deletes v from the subtree of n of level lvl.
+// Nodes started by v are merged into their left neighbors.
+func (l *PersistentList[T]) del(n *pnode[T], lvl int, v T) (_ *pnode[T], old T, ok bool) {
+	if lvl == 1 {
+		i, eq := l.searchVals(n, v)
+		if !eq {
+			return n, old, false
+		}
+
+		old = n.vals[i]
+
+		n = l.own(n)
+		n.vals = slices.Delete(n.vals, i, i+1)
+
+		return n, old, true
+	}
+
+	i := l.searchKids(n, v)
+
+	if i > 0 && !l.less(n.keys[i], v) {
+		n = l.own(n)
+
+		n.kids[i-1], old = l.merge(n.kids[i-1], n.kids[i], lvl-1)
+
+		n.kids = slices.Delete(n.kids, i, i+1)
+		n.keys = slices.Delete(n.keys, i, i+1)
+
+		return n, old, true
+	}
+
+	c, old, ok := l.del(n.kids[i], lvl-1, v)
+	if !ok {
+		return n, old, false
+	}
+
+	if c != n.kids[i] {
+		n = l.own(n)
+		n.kids[i] = c
+	}
+
+	return n, old, true
+}
+
+// merge appends b to a dropping the first b element at each level,
+// which is the deleted one. It returns its value.
+func (l *PersistentList[T]) merge(a, b *pnode[T], lvl int) (*pnode[T], T) {
+	a = l.own(a)
+
+	if lvl == 1 {
+		a.vals = append(a.vals, b.vals[1:]...)
+
+		return a, b.vals[0]
+	}
+
+	last := len(a.kids) - 1
+
+	var old T
+	a.kids[last], old = l.merge(a.kids[last], b.kids[0], lvl-1)
+
+	a.kids = append(a.kids, b.kids[1:]...)
+	a.keys = append(a.keys, b.keys[1:]...)
+
+	return a, old
+}
+
+// searchVals returns index of the first value not less than v
+// and whether it's equal to v.
+func (l *PersistentList[T]) searchVals(n *pnode[T], v T) (int, bool) {
+	i := sort.Search(len(n.vals), func(i int) bool {
+		return !l.less(n.vals[i], v)
+	})
+
+	return i, i < len(n.vals) && !l.less(v, n.vals[i])
+}
+
+// searchKids returns index of the last kid started not after v.
+func (l *PersistentList[T]) searchKids(n *pnode[T], v T) int {
+	return sort.Search(len(n.kids)-1, func(i int) bool {
+		return l.less(v, n.keys[i+1])
+	})
+}
+
+// own returns n if it's owned by the list or its copy overwise.
+func (l *PersistentList[T]) own(n *pnode[T]) *pnode[T] {
+	if n.gen == l.gen {
+		return n
+	}
+
+	return &pnode[T]{
+		gen:  l.gen,
+		vals: slices.Clone(n.vals),
+		kids: slices.Clone(n.kids),
+		keys: slices.Clone(n.keys),
+	}
+}
+
+func (l *PersistentList[T]) node() *pnode[T] {
+	return &pnode[T]{gen: l.gen}
+}
+
+func (n *pnode[T]) all(lvl int, yield func(T) bool) bool {
+	if lvl == 1 {
+		for _, v := range n.vals {
+			if !yield(v) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	for _, k := range n.kids {
+		if !k.all(lvl-1, yield) {
+			return false
+		}
+	}
+
+	return true
+}
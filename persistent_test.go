@@ -0,0 +1,274 @@
+package skiplist
+
+import (
+	"fmt"
+	"maps"
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPersistent(t *testing.T) {
+	const N = 5000
+
+	l := NewPersistent(IntLess, WithSeed(*seed))
+	exp := make(map[int]int)
+
+	type snap struct {
+		l   *PersistentList[int]
+		exp []int
+	}
+
+	var snaps []snap
+
+	for i := 0; i < N; i++ {
+		v := rnd.Intn(N / 4)
+
+		switch rnd.Intn(3) {
+		case 0:
+			old, ok := l.Del(v)
+			_, had := exp[v]
+			assert.Equal(t, had, ok)
+
+			if ok {
+				assert.Equal(t, v, old)
+				delete(exp, v)
+			}
+		default:
+			_, had := exp[v]
+			assert.Equal(t, !had, l.Put(v))
+			exp[v] = v
+		}
+
+		if i%100 == 0 {
+			snaps = append(snaps, snap{l: l.Snapshot(), exp: slices.Sorted(maps.Keys(exp))})
+			checkPersistent(t, l)
+		}
+	}
+
+	checkPersistent(t, l)
+	assert.Equal(t, slices.Sorted(maps.Keys(exp)), slices.Collect(l.All()))
+
+	for v := 0; v < N/4; v++ {
+		_, ok := l.Get(v)
+		_, had := exp[v]
+		assert.Equal(t, had, ok, "value %d", v)
+	}
+
+	for _, s := range snaps {
+		assert.Equal(t, s.exp, slices.Collect(s.l.All()))
+		assert.Equal(t, len(s.exp), s.l.Len())
+		checkPersistent(t, s.l)
+	}
+
+	s := snaps[len(snaps)/2]
+	for _, v := range s.exp {
+		s.l.Del(v)
+	}
+
+	assert.Equal(t, 0, s.l.Len())
+	assert.Equal(t, slices.Sorted(maps.Keys(exp)), slices.Collect(l.All()))
+	assert.Equal(t, snaps[len(snaps)/2+1].exp, slices.Collect(snaps[len(snaps)/2+1].l.All()))
+}
+
+func TestPersistentOverwrite(t *testing.T) {
+	type kv struct{ k, v int }
+
+	l := NewPersistent(func(a, b kv) bool { return a.k < b.k }, WithSeed(*seed))
+
+	for i := 0; i < 100; i++ {
+		l.Put(kv{k: i})
+	}
+
+	s := l.Snapshot()
+	lvl := l.lvl
+
+	for i := 0; i < 100; i++ {
+		assert.False(t, l.Put(kv{k: i, v: i}))
+	}
+
+	assert.Equal(t, lvl, l.lvl)
+
+	one := NewPersistent(IntLess, WithSeed(*seed))
+	one.Put(1)
+
+	lvl = one.lvl
+
+	for i := 0; i < 1000; i++ {
+		one.Put(1)
+	}
+
+	assert.Equal(t, lvl, one.lvl)
+	assert.Equal(t, 1, one.Len())
+
+	for i := 0; i < 100; i++ {
+		x, ok := l.Get(kv{k: i})
+		assert.True(t, ok)
+		assert.Equal(t, i, x.v)
+
+		x, _ = s.Get(kv{k: i})
+		assert.Equal(t, 0, x.v)
+	}
+}
+
+func TestPersistentConcurrent(t *testing.T) {
+	const N = 2000
+
+	l := NewPersistent(IntLess, WithSeed(*seed))
+	snaps := make(chan *PersistentList[int])
+
+	var wg sync.WaitGroup
+
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for s := range snaps {
+				var got []int
+				for v := range s.All() {
+					got = append(got, v)
+				}
+
+				assert.True(t, slices.IsSorted(got))
+				assert.Equal(t, s.Len(), len(got))
+			}
+		}()
+	}
+
+	r := rnd.Int()
+
+	for i := 0; i < N; i++ {
+		r = r*1103515245 + 12345
+		v := r >> 8 & 1023
+
+		if i%3 == 0 {
+			l.Del(v)
+		} else {
+			l.Put(v)
+		}
+
+		if i%20 == 0 {
+			snaps <- l.Snapshot()
+		}
+	}
+
+	close(snaps)
+	wg.Wait()
+}
+
+func TestPersistentSnapshotRand(t *testing.T) {
+	const N = 1000
+
+	l := NewPersistent(IntLess, WithRand(rand.NewSource(*seed))) // not safe for concurrent use
+	s := l.Snapshot()
+
+	assert.True(t, l.rnd != s.rnd, "shared random source")
+
+	var wg sync.WaitGroup
+
+	for _, l := range []*PersistentList[int]{l, s} {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < N; i++ {
+				l.Put(i)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, N, l.Len())
+	assert.Equal(t, N, s.Len())
+	checkPersistent(t, l)
+	checkPersistent(t, s)
+}
+
+func checkPersistent[T any](t *testing.T, l *PersistentList[T]) {
+	t.Helper()
+
+	var first func(n *pnode[T], lvl int) (T, bool)
+	first = func(n *pnode[T], lvl int) (T, bool) {
+		for ; lvl > 1; lvl-- {
+			n = n.kids[0]
+		}
+
+		if len(n.vals) == 0 {
+			var zero T
+			return zero, false
+		}
+
+		return n.vals[0], true
+	}
+
+	var check func(n *pnode[T], lvl int, head bool)
+	check = func(n *pnode[T], lvl int, head bool) {
+		if lvl == 1 {
+			if !head && len(n.vals) == 0 {
+				t.Errorf("empty leaf")
+			}
+
+			return
+		}
+
+		if len(n.kids) != len(n.keys) {
+			t.Errorf("level %d: %d kids %d keys", lvl, len(n.kids), len(n.keys))
+		}
+
+		for i, k := range n.kids {
+			if i > 0 {
+				f, ok := first(k, lvl-1)
+				if !ok || l.less(f, n.keys[i]) || l.less(n.keys[i], f) {
+					t.Errorf("level %d: kid %d: key %v, first %v", lvl, i, n.keys[i], f)
+				}
+			}
+
+			check(k, lvl-1, head && i == 0)
+		}
+	}
+
+	check(l.root, l.lvl, true)
+
+	vals := slices.Collect(l.All())
+
+	assert.Equal(t, l.Len(), len(vals))
+
+	for i := 1; i < len(vals); i++ {
+		if !l.less(vals[i-1], vals[i]) {
+			t.Errorf("not ordered at %d: %v %v", i, vals[i-1], vals[i])
+		}
+	}
+}
+
+func BenchmarkPersistentPut(b *testing.B) {
+	for _, every := range []int{0, 1, 100} {
+		b.Run(fmt.Sprintf("snapshot_every_%d", every), func(b *testing.B) {
+			b.ReportAllocs()
+
+			l := NewPersistent(IntLess, WithSeed(*seed))
+
+			for i := 0; i < b.N; i++ {
+				l.Put(i * 7919 % b.N)
+
+				if every != 0 && i%every == 0 {
+					_ = l.Snapshot()
+				}
+			}
+		})
+	}
+}
+
+func TestPersistentOptions(t *testing.T) {
+	l := NewPersistent(IntLess, WithMaxHeight(3), WithP(0.25), WithSeed(1))
+	assert.Equal(t, 3, l.maxh)
+
+	assert.Panics(t, func() { NewPersistent(IntLess, WithBackLinks(true)) })
+	assert.Panics(t, func() { NewPersistent(IntLess, WithAllocator[int](NewArena[int](0))) })
+}
@@ -0,0 +1,52 @@
+package skiplist
+
+// PeekFirst returns the first value.
+// Second returned argument is false if list is empty.
+func (l *List[T]) PeekFirst() (v T, ok bool) {
+	e := l.First()
+	if e == nil {
+		return
+	}
+
+	return e.val, true
+}
+
+// PopFirst deletes the first element and returns its value.
+// It needs no comparisons nor search, the element is unlinked from the head directly.
+// Second returned argument is false if list is empty.
+func (l *List[T]) PopFirst() (v T, ok bool) {
+	e := l.zero.Next()
+	if e == nil {
+		return v, false
+	}
+
+	top := l.zero.height()
+
+	for i := 0; i < top; i++ {
+		l.up[i] = &l.zero
+	}
+
+	return l.del(e, top), true
+}
+
+// PopLast deletes the last element and returns its value.
+// It needs no comparisons.
+// Second returned argument is false if list is empty.
+func (l *List[T]) PopLast() (v T, ok bool) {
+	return l.DelAt(l.len - 1)
+}
+
+// PopWhile deletes the longest prefix of elements for which f returns true.
+// f is called with elements in order until it returns false.
+// Prefix is unlinked at once. It returns number of deleted elements.
+func (l *List[T]) PopWhile(f func(v T) bool) int {
+	k := 0
+	for e := l.First(); e != nil && f(e.val); e = e.Next() {
+		k++
+	}
+
+	l.findPos(1, true)
+	l.cut(k, nil)
+
+	return k
+}
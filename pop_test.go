@@ -0,0 +1,92 @@
+package skiplist
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPop(t *testing.T) {
+	l := NewRepeated(IntLess, WithSeed(*seed))
+
+	_, ok := l.PeekFirst()
+	assert.False(t, ok)
+
+	_, ok = l.PopFirst()
+	assert.False(t, ok)
+
+	_, ok = l.PopLast()
+	assert.False(t, ok)
+
+	for _, v := range []int{5, 1, 3, 1, 9, 7} {
+		l.Put(v)
+	}
+
+	v, ok := l.PeekFirst()
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	v, _ = l.PopFirst()
+	assert.Equal(t, 1, v)
+
+	v, _ = l.PopLast()
+	assert.Equal(t, 9, v)
+
+	assert.Equal(t, []int{1, 3, 5, 7}, slices.Collect(l.All()))
+	checkDist(t, l)
+
+	var seen []int
+	n := l.PopWhile(func(v int) bool {
+		seen = append(seen, v)
+		return v < 5
+	})
+
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []int{1, 3, 5}, seen)
+	assert.Equal(t, []int{5, 7}, slices.Collect(l.All()))
+	checkDist(t, l)
+
+	assert.Equal(t, 2, l.PopWhile(func(int) bool { return true }))
+	assert.Equal(t, 0, l.Len())
+	assert.Equal(t, 0, l.PopWhile(func(int) bool { return true }))
+}
+
+func TestPopQueue(t *testing.T) {
+	t.Run("plain", func(t *testing.T) { testPopQueue(t, NewRepeated(IntLess, WithSeed(*seed))) })
+	t.Run("back_links", func(t *testing.T) { testPopQueue(t, NewRepeated(IntLess, WithSeed(*seed), WithBackLinks(true))) })
+}
+
+func testPopQueue(t *testing.T, l *List[int]) {
+	const N = 3000
+
+	var exp []int
+
+	for i := 0; i < N; i++ {
+		v := rnd.Intn(N)
+
+		l.Put(v)
+		exp = append(exp, v)
+
+		if rnd.Intn(3) == 0 {
+			slices.Sort(exp)
+
+			v, _ := l.PopFirst()
+			assert.Equal(t, exp[0], v)
+			exp = exp[1:]
+		}
+
+		if len(exp) > 0 && rnd.Intn(5) == 0 {
+			slices.Sort(exp)
+
+			v, _ := l.PopLast()
+			assert.Equal(t, exp[len(exp)-1], v)
+			exp = exp[:len(exp)-1]
+		}
+	}
+
+	slices.Sort(exp)
+
+	assert.Equal(t, exp, slices.Collect(l.All()))
+	checkDist(t, l)
+}
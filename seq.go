@@ -0,0 +1,60 @@
+package skiplist
+
+import "iter"
+
+// All returns iterator over all values in order.
+func (l *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.First(); e != nil; e = e.Next() {
+			if !yield(e.val) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns iterator over index and value pairs in order.
+func (l *List[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for e := l.First(); e != nil; e = e.Next() {
+			if !yield(i, e.val) {
+				return
+			}
+
+			i++
+		}
+	}
+}
+
+// Range returns iterator over values in [lo, hi) range.
+func (l *List[T]) Range(lo, hi T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		it := l.IterRange(lo, hi)
+
+		for ok := it.First(); ok; ok = it.Next() {
+			if !yield(it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns iterator over all values in reverse order.
+// Each step costs a search unless list has back links.
+func (l *List[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Last(); e != nil; e = l.Prev(e) {
+			if !yield(e.val) {
+				return
+			}
+		}
+	}
+}
+
+// All returns iterator over key-value pairs in order.
+func (m *Map[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.Range(yield)
+	}
+}
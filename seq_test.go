@@ -0,0 +1,64 @@
+package skiplist
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeq(t *testing.T) {
+	l := New(IntLess)
+
+	for _, v := range []int{5, 1, 9, 3, 7} {
+		l.Put(v)
+	}
+
+	assert.Equal(t, []int{1, 3, 5, 7, 9}, slices.Collect(l.All()))
+	assert.Equal(t, []int{9, 7, 5, 3, 1}, slices.Collect(l.Backward()))
+	assert.Equal(t, []int{3, 5}, slices.Collect(l.Range(2, 7)))
+
+	var idx []int
+	for i, v := range l.Enumerate() {
+		if v > 5 {
+			break
+		}
+
+		idx = append(idx, i)
+	}
+	assert.Equal(t, []int{0, 1, 2}, idx)
+
+	for range l.All() {
+		break
+	}
+
+	for range l.Backward() {
+		break
+	}
+
+	for range l.Range(0, 10) {
+		break
+	}
+}
+
+func TestMapSeq(t *testing.T) {
+	m := NewMap[int, string](IntLess)
+
+	m.Set(2, "b")
+	m.Set(1, "a")
+	m.Set(3, "c")
+
+	var keys []int
+	var vals []string
+	for k, v := range m.All() {
+		if k == 3 {
+			break
+		}
+
+		keys = append(keys, k)
+		vals = append(vals, v)
+	}
+
+	assert.Equal(t, []int{1, 2}, keys)
+	assert.Equal(t, []string{"a", "b"}, vals)
+}
@@ -0,0 +1,157 @@
+package skiplist
+
+import "iter"
+
+// Union returns new list with elements of both lists. Lists must have the same order.
+// The result has a settings, so lists must be compatible as for Merge.
+// That holds for the other list returning set operations too.
+// Repeated elements are treated as multisets: each is taken max(na, nb) times.
+func Union[T any](a, b *List[T]) *List[T] {
+	checkJoin(a, b)
+
+	return a.collect(UnionSeq(a, b))
+}
+
+// Intersect returns new list with elements present in both lists.
+// Repeated elements are taken min(na, nb) times.
+// Runs of non-matching elements are skipped in O(log d).
+func Intersect[T any](a, b *List[T]) *List[T] {
+	checkJoin(a, b)
+
+	return a.collect(IntersectSeq(a, b))
+}
+
+// Difference returns new list with elements of a not present in b.
+// Repeated elements are taken na-nb times.
+// Runs of b elements not in a are skipped in O(log d).
+func Difference[T any](a, b *List[T]) *List[T] {
+	checkJoin(a, b)
+
+	return a.collect(DifferenceSeq(a, b))
+}
+
+// SymmetricDifference returns new list with elements present in exactly one of the lists.
+// Repeated elements are taken |na-nb| times.
+func SymmetricDifference[T any](a, b *List[T]) *List[T] {
+	checkJoin(a, b)
+
+	return a.collect(SymmetricDifferenceSeq(a, b))
+}
+
+// UnionSeq is a streaming version of Union.
+// Lists must not be modified while iterating.
+func UnionSeq[T any](a, b *List[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		x, y := a.First(), b.First()
+
+		for x != nil || y != nil {
+			var v T
+
+			switch {
+			case y == nil || x != nil && a.less(x.val, y.val):
+				v, x = x.val, x.Next()
+			case x == nil || a.less(y.val, x.val):
+				v, y = y.val, y.Next()
+			default:
+				v, x, y = x.val, x.Next(), y.Next()
+			}
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// IntersectSeq is a streaming version of Intersect.
+// Lists must not be modified while iterating.
+func IntersectSeq[T any](a, b *List[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		x, y := a.First(), b.First()
+
+		for x != nil && y != nil {
+			switch {
+			case a.less(x.val, y.val):
+				x = a.ceilFrom(x, y.val)
+			case a.less(y.val, x.val):
+				y = b.ceilFrom(y, x.val)
+			default:
+				if !yield(x.val) {
+					return
+				}
+
+				x, y = x.Next(), y.Next()
+			}
+		}
+	}
+}
+
+// DifferenceSeq is a streaming version of Difference.
+// Lists must not be modified while iterating.
+func DifferenceSeq[T any](a, b *List[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		x, y := a.First(), b.First()
+
+		for x != nil {
+			if y != nil && a.less(y.val, x.val) {
+				y = b.ceilFrom(y, x.val)
+			}
+
+			if y != nil && !a.less(x.val, y.val) {
+				x, y = x.Next(), y.Next()
+				continue
+			}
+
+			if !yield(x.val) {
+				return
+			}
+
+			x = x.Next()
+		}
+	}
+}
+
+// SymmetricDifferenceSeq is a streaming version of SymmetricDifference.
+// Lists must not be modified while iterating.
+func SymmetricDifferenceSeq[T any](a, b *List[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		x, y := a.First(), b.First()
+
+		for x != nil || y != nil {
+			var v T
+
+			switch {
+			case y == nil || x != nil && a.less(x.val, y.val):
+				v, x = x.val, x.Next()
+			case x == nil || a.less(y.val, x.val):
+				v, y = y.val, y.Next()
+			default:
+				x, y = x.Next(), y.Next()
+				continue
+			}
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ceilFrom returns the first element not less than v searching from e, which must be less than v.
+func (l *List[T]) ceilFrom(e *El[T], v T) *El[T] {
+	cur, _ := l.findFrom(e, v, true, false)
+
+	return cur.Next()
+}
+
+// collect makes new list with the same settings from sorted values.
+func (l *List[T]) collect(seq iter.Seq[T]) *List[T] {
+	r := l.empty()
+	r.front()
+
+	for v := range seq {
+		r.push(v, r.rndHeight())
+	}
+
+	return r
+}
@@ -0,0 +1,155 @@
+package skiplist
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetOps(t *testing.T) {
+	a := New(IntLess)
+	b := New(IntLess)
+
+	a.PutSorted([]int{1, 2, 3, 5, 8, 13})
+	b.PutSorted([]int{2, 3, 4, 5, 6, 7, 14})
+
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 13, 14}, slices.Collect(Union(a, b).All()))
+	assert.Equal(t, []int{2, 3, 5}, slices.Collect(Intersect(a, b).All()))
+	assert.Equal(t, []int{1, 8, 13}, slices.Collect(Difference(a, b).All()))
+	assert.Equal(t, []int{4, 6, 7, 14}, slices.Collect(Difference(b, a).All()))
+	assert.Equal(t, []int{1, 4, 6, 7, 8, 13, 14}, slices.Collect(SymmetricDifference(a, b).All()))
+
+	e := New(IntLess)
+
+	assert.Equal(t, 0, Intersect(a, e).Len())
+	assert.Equal(t, 6, Union(e, a).Len())
+	assert.Equal(t, 6, Difference(a, e).Len())
+	assert.Equal(t, 6, SymmetricDifference(e, a).Len())
+
+	for range UnionSeq(a, b) {
+		break
+	}
+
+	for range IntersectSeq(a, b) {
+		break
+	}
+
+	for range DifferenceSeq(a, b) {
+		break
+	}
+
+	for range SymmetricDifferenceSeq(a, b) {
+		break
+	}
+}
+
+func TestSetOpsRandom(t *testing.T) {
+	const M = 3000
+
+	a := New(IntLess, WithSeed(*seed))
+	b := New(IntLess, WithSeed(*seed+1))
+	ina := make(map[int]bool)
+	inb := make(map[int]bool)
+
+	for i := 0; i < M; i++ {
+		v := rnd.Intn(M)
+		a.Put(v)
+		ina[v] = true
+
+		v = rnd.Intn(M)
+		b.Put(v)
+		inb[v] = true
+	}
+
+	var union, inter, diff, sym []int
+	for v := 0; v < M; v++ {
+		if ina[v] || inb[v] {
+			union = append(union, v)
+		}
+		if ina[v] && inb[v] {
+			inter = append(inter, v)
+		}
+		if ina[v] && !inb[v] {
+			diff = append(diff, v)
+		}
+		if ina[v] != inb[v] {
+			sym = append(sym, v)
+		}
+	}
+
+	for _, tc := range []struct {
+		name string
+		l    *List[int]
+		exp  []int
+	}{
+		{"union", Union(a, b), union},
+		{"intersect", Intersect(a, b), inter},
+		{"difference", Difference(a, b), diff},
+		{"symmetric", SymmetricDifference(a, b), sym},
+	} {
+		assert.Equal(t, tc.exp, slices.Collect(tc.l.All()), tc.name)
+		assert.Equal(t, len(tc.exp), tc.l.Len(), tc.name)
+		checkDist(t, tc.l)
+	}
+}
+
+func TestSetOpsRepeated(t *testing.T) {
+	a := NewRepeated(IntLess)
+	b := NewRepeated(IntLess)
+
+	a.PutSorted([]int{1, 1, 1, 2, 3, 3})
+	b.PutSorted([]int{1, 2, 2, 3, 3, 3})
+
+	assert.Equal(t, []int{1, 1, 1, 2, 2, 3, 3, 3}, slices.Collect(UnionSeq(a, b)))
+	assert.Equal(t, []int{1, 2, 3, 3}, slices.Collect(IntersectSeq(a, b)))
+	assert.Equal(t, []int{1, 1}, slices.Collect(DifferenceSeq(a, b)))
+	assert.Equal(t, []int{1, 1, 2, 3}, slices.Collect(SymmetricDifferenceSeq(a, b)))
+}
+
+func TestIntersectGallop(t *testing.T) {
+	const N = 100000
+
+	var calls int
+	less := func(a, b int) bool {
+		calls++
+		return a < b
+	}
+
+	big := New(less, WithSeed(*seed))
+	small := New(less, WithSeed(*seed))
+
+	for i := 0; i < N; i++ {
+		big.Put(i)
+	}
+
+	for i := 0; i < N; i += N / 10 {
+		small.Put(i + 1)
+	}
+
+	calls = 0
+
+	assert.Equal(t, 10, Intersect(small, big).Len())
+	assert.True(t, calls < 1000, "too many calls: %d", calls)
+
+	t.Logf("less calls: %d", calls)
+}
+
+func TestSetOpsMismatch(t *testing.T) {
+	a := New(IntLess)
+	a.Put(2)
+
+	b := NewRepeated(IntLess)
+	b.Put(1)
+	b.Put(1)
+
+	assert.Panics(t, func() { Union(a, b) })
+	assert.Panics(t, func() { SymmetricDifference(a, b) })
+	assert.Panics(t, func() { Intersect(a, b) })
+	assert.Panics(t, func() { Difference(a, b) })
+	assert.Panics(t, func() { Union(a, New(IntLess, WithMaxHeight(5))) })
+
+	r := Union(b, a)
+	assert.Equal(t, []int{1, 1, 2}, slices.Collect(r.All()))
+	assert.True(t, r.repeat)
+}
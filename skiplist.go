@@ -3,76 +3,192 @@ package skiplist
 import (
 	"bytes"
 	"fmt"
-	"math/rand"
 	"sync"
 )
 
 const (
-	FixedHeight = 4
+	FixedHeight      = 4 // links allocated with each element in FixedLayout
+	DefaultMaxHeight = 30
 )
 
-var (
-	MaxHeight = 30
-)
+// deleted is level 0 distance of deleted element.
+const deleted = -1
 
 type (
-	LessFunc func(a, b interface{} /* val */) bool
-	List     struct {
-		less      LessFunc
-		repeat    bool
-		autoreuse bool
-		len       int
-		zero      El
-		up        []**El
-	}
-	El struct {
-		val  interface{} /* val */
+	LessFunc[T any]    func(a, b T) bool
+	CompareFunc[T any] func(a, b T) int
+	List[T any]        struct {
+		config
+
+		less  LessFunc[T]
+		cmp   CompareFunc[T] // optional
+		alloc Allocator[T]
+		len   int
+		zero  El[T]
+		up    []*El[T]
+		pos   []int
+	}
+	El[T any] struct {
+		val  T
 		h    int
-		next [FixedHeight]*El
-		more []*El
+		next link[T]   // level 0
+		more []link[T] // upper levels and back links if list has them, usually allocated with the element
 	}
-)
 
-var pool = sync.Pool{New: func() interface{} { return &El{} }}
+	// link points to the next element at the level.
+	// dist is the number of elements from the previous element at the level to this one.
+	link[T any] struct {
+		next *El[T]
+		dist int
+	}
+)
 
 // New creates skiplist without repeated elements
-func New(less LessFunc) *List {
-	return &List{
-		less:      less,
-		zero:      El{h: MaxHeight, more: make([]*El, MaxHeight-FixedHeight)},
-		up:        make([]**El, MaxHeight),
-		autoreuse: true,
-	}
+func New[T any](less LessFunc[T], opts ...Option) *List[T] {
+	return NewWithOptions(less, opts...)
 }
 
 // NewRepeated creates skiplist with possible repeated elements
-func NewRepeated(less LessFunc) *List {
-	l := New(less)
-	l.repeat = true
+func NewRepeated[T any](less LessFunc[T], opts ...Option) *List[T] {
+	return NewWithOptions(less, append(opts[:len(opts):len(opts)], WithRepeat(true))...)
+}
+
+// NewCompare creates skiplist ordered by three-way comparison function.
+// It saves less calls needed to check elements equality.
+func NewCompare[T any](cmp CompareFunc[T], opts ...Option) *List[T] {
+	l := NewWithOptions(cmp.Less, opts...)
+	l.cmp = cmp
+
+	return l
+}
+
+// NewWithOptions creates skiplist configured by opts.
+// Default is a list without repeated elements, with auto reuse disabled.
+func NewWithOptions[T any](less LessFunc[T], opts ...Option) *List[T] {
+	l := &List[T]{
+		config: newConfig(opts),
+		less:   less,
+	}
+
+	switch a := l.config.alloc.(type) {
+	case nil:
+		if l.config.pool == nil {
+			l.config.pool = new(sync.Pool)
+		}
+
+		l.alloc = poolAlloc[T]{p: l.config.pool, layout: l.config.layout}
+	case Allocator[T]:
+		l.alloc = a
+	default:
+		panic(fmt.Sprintf("skiplist: allocator %T doesn't match element type %T", a, l.zero.val))
+	}
+
+	l.init()
+
 	return l
 }
 
+// empty returns new empty list with the same settings.
+func (l *List[T]) empty() *List[T] {
+	r := &List[T]{
+		config: l.config,
+		less:   l.less,
+		cmp:    l.cmp,
+		alloc:  l.alloc,
+	}
+
+	r.init()
+
+	return r
+}
+
+func (l *List[T]) init() {
+	l.zero.h = l.maxh
+	l.zero.more = make([]link[T], l.towerHeight(l.maxh)-1) // zero back links are always nil
+
+	l.up = make([]*El[T], l.maxh)
+	l.pos = make([]int, l.maxh)
+}
+
 // First returns first element or nil
-func (l *List) First() *El {
+func (l *List[T]) First() *El[T] {
 	return l.zero.Next()
 }
 
+// Last returns last element or nil
+func (l *List[T]) Last() *El[T] {
+	cur := &l.zero
+
+	for i := cur.height() - 1; i >= 0; i-- {
+		for n := cur.nexti(i); n != nil; n = cur.nexti(i) {
+			cur = n
+		}
+	}
+
+	if cur == &l.zero {
+		return nil
+	}
+
+	return cur
+}
+
+// Prev returns element preceding e or nil.
+// Without back links it costs a search.
+// With back links it's nil for deleted elements.
+func (l *List[T]) Prev(e *El[T]) *El[T] {
+	if debug {
+		e.check()
+	}
+
+	if l.backlinks {
+		p := e.backi(0)
+		if p == nil {
+			return nil
+		}
+
+		return l.nonzero(p)
+	}
+
+	p, _, _ := l.find(e.val, true, false)
+
+	for n := p.Next(); n != nil && n != e; n = n.Next() {
+		p = n
+	}
+
+	if p == &l.zero {
+		return nil
+	}
+
+	return p
+}
+
 // Len returns length if list
-func (l *List) Len() int {
+func (l *List[T]) Len() int {
 	return l.len
 }
 
-// SetAutoReuse enables of disables auto Reuse of deleted elements.
-// It is enabled by default.
-func (l *List) SetAutoReuse(v bool) {
+// Reset deletes all elements in O(1) as Clear(false) does.
+// If list allocator has Reset method, as Arena does, it's called too,
+// so such allocator must not be shared with other lists.
+func (l *List[T]) Reset() {
+	l.Clear(false)
+
+	if r, ok := l.alloc.(interface{ Reset() }); ok {
+		r.Reset()
+	}
+}
+
+// SetAutoReuse enables of disables auto reuse of deleted elements.
+// See WithAutoReuse.
+func (l *List[T]) SetAutoReuse(v bool) {
 	l.autoreuse = v
 }
 
 // Get returns first occurrence of element equal to v (equal defined as !less(e, v) && !less(v, e)) or nil if it doesn't exists.
-func (l *List) Get(v interface{} /* val */) *El {
-	cur := l.search(v, true, false)
+func (l *List[T]) Get(v T) *El[T] {
+	cur, eq := l.search(v, true, false)
 
-	if cur == nil || l.less(v, cur.val) {
+	if !eq {
 		return nil
 	}
 
@@ -80,23 +196,51 @@ func (l *List) Get(v interface{} /* val */) *El {
 }
 
 // Get returns last occurrence of element equal to v (equal defined as !less(e, v) && !less(v, e)) or nil if it doesn't exists.
-func (l *List) GetLast(v interface{} /* val */) *El {
-	cur := l.search(v, false, false)
+func (l *List[T]) GetLast(v T) *El[T] {
+	cur, eq := l.search(v, false, false)
 
-	if cur == &l.zero || l.less(cur.val, v) {
+	if !eq {
 		return nil
 	}
 
 	return cur
 }
 
+// Floor returns the last element not greater than v or nil.
+func (l *List[T]) Floor(v T) *El[T] {
+	cur, _, _ := l.find(v, false, false)
+
+	return l.nonzero(cur)
+}
+
+// Ceil returns the first element not less than v or nil.
+func (l *List[T]) Ceil(v T) *El[T] {
+	cur, _, _ := l.find(v, true, false)
+
+	return cur.Next()
+}
+
+// Lower returns the last element less than v or nil.
+func (l *List[T]) Lower(v T) *El[T] {
+	cur, _, _ := l.find(v, true, false)
+
+	return l.nonzero(cur)
+}
+
+// Higher returns the first element greater than v or nil.
+func (l *List[T]) Higher(v T) *El[T] {
+	cur, _, _ := l.find(v, false, false)
+
+	return cur.Next()
+}
+
 // Put puts new value. If it is list with repititions, than it adds new copy after all equals.
 // Overwise it rewrites (not replaces) existing.
 // Second returned argument is true if there wasn't such element.
-func (l *List) Put(v interface{} /* val */) (*El, bool) {
-	cur := l.search(v, false, true)
+func (l *List[T]) Put(v T) (*El[T], bool) {
+	cur, eq := l.search(v, false, true)
 
-	if !l.repeat && cur != &l.zero && !l.less(cur.val, v) {
+	if !l.repeat && eq {
 		cur.val = v
 		return cur, false
 	}
@@ -107,10 +251,10 @@ func (l *List) Put(v interface{} /* val */) (*El, bool) {
 // Put puts new value. If it is list with repititions, than it adds new copy before all equals.
 // Overwise it rewrites (not replaces) existing.
 // Second returned argument is true if there wasn't such element.
-func (l *List) PutBefore(v interface{} /* val */) (*El, bool) {
-	cur := l.search(v, true, true)
+func (l *List[T]) PutBefore(v T) (*El[T], bool) {
+	cur, eq := l.search(v, true, true)
 
-	if !l.repeat && cur != nil && !l.less(v, cur.val) {
+	if !l.repeat && eq {
 		cur.val = v
 		return cur, false
 	}
@@ -120,181 +264,397 @@ func (l *List) PutBefore(v interface{} /* val */) (*El, bool) {
 
 // GetOrPut gets first occurrence or add new and returns it.
 // Second returned argument is true if there wasn't such element.
-func (l *List) GetOrPut(v interface{} /* val */) (*El, bool) {
-	cur := l.search(v, true, true)
+func (l *List[T]) GetOrPut(v T) (*El[T], bool) {
+	cur, eq := l.search(v, true, true)
 
-	if cur != nil && !l.less(v, cur.val) {
+	if eq {
 		return cur, false
 	}
 
 	return l.rndEl(v), true
 }
 
-// Del deletes first occurrence equals to v and returns it or nil if it wasn't existed
-func (l *List) Del(v interface{} /* val */) *El {
-	cur := l.search(v, true, true)
+// Del deletes first occurrence equal to v and returns its value.
+// Second returned argument is false if there was no such element.
+func (l *List[T]) Del(v T) (T, bool) {
+	cur, eq := l.search(v, true, true)
 
-	if cur == nil || l.less(v, cur.val) {
-		return nil
+	if !eq {
+		var zero T
+		return zero, false
 	}
 
-	l.len--
+	return l.del(cur, l.zero.height()), true
+}
 
-	h := cur.height()
-	for i := h - 1; i >= 0; i-- {
-		*l.up[i] = cur.nexti(i)
+// DelEl deletes e from the list.
+// It returns false if e wasn't found.
+// With back links it makes no comparisons: e is checked to be in the list
+// by following back links up to the head in O(log n) expected steps.
+func (l *List[T]) DelEl(e *El[T]) bool {
+	if debug {
+		e.check()
 	}
 
-	if l.autoreuse {
-		Reuse(cur)
+	if l.backlinks {
+		if !l.owns(e) {
+			return false
+		}
+
+		h := e.height()
+		for i := 0; i < h; i++ {
+			l.up[i] = e.backi(i)
+		}
+
+		l.del(e, h)
+
+		return true
 	}
 
-	return cur
-}
+	_, ok := l.DelIf(e.val, func(b *El[T]) bool { return e == b })
 
-func (l *List) DelEl(e *El) *El {
-	return l.DelIf(e.Value(), func(b *El) bool { return e == b })
+	return ok
 }
 
-func (l *List) DelIf(v interface{} /* val */, f func(*El) bool) *El {
-	cur := l.search(v, true, true)
+// DelIf deletes first occurrence equal to v for which f returns true and returns its value.
+// Second returned argument is false if there was no such element.
+func (l *List[T]) DelIf(v T, f func(*El[T]) bool) (T, bool) {
+	cur, eq := l.search(v, true, true)
 
-	for cur != nil && !l.less(v, cur.val) && !f(cur) {
-		next := cur.Next()
+	pos := l.pos[0] + 1
+	for eq && !f(cur) {
 		for i := 0; i < cur.height(); i++ {
-			l.up[i] = cur.nextiaddr(i)
+			l.up[i] = cur
+			l.pos[i] = pos
 		}
-		cur = next
+		cur = cur.Next()
+		pos++
+		eq = cur != nil && !l.less(v, cur.val)
 	}
 
-	if cur == nil || l.less(v, cur.val) {
-		return nil
+	if !eq {
+		var zero T
+		return zero, false
 	}
 
-	l.len--
-
-	h := cur.height()
-	for i := h - 1; i >= 0; i-- {
-		*l.up[i] = cur.nexti(i)
-	}
+	return l.del(cur, l.zero.height()), true
+}
 
-	if l.autoreuse {
-		Reuse(cur)
+func (l *List[T]) nonzero(e *El[T]) *El[T] {
+	if e == &l.zero {
+		return nil
 	}
 
-	return cur
+	return e
 }
 
-func (l *List) search(v interface{} /* val */, first, upd bool) *El {
-	cur := &l.zero
-
-	for {
-		next := l.jump(cur, v, first, upd)
-		if next == nil {
-			break
-		}
-
-		cur = next
-	}
+// search returns the first element not less than v if first or the last not greater than v overwise.
+// Second returned argument is true if the element is equal to v.
+func (l *List[T]) search(v T, first, upd bool) (*El[T], bool) {
+	cur, _, c := l.find(v, first, upd)
 
 	if first {
 		cur = cur.Next()
 	}
 
-	return cur
+	switch {
+	case cur == nil || cur == &l.zero:
+		return cur, false
+	case l.cmp != nil:
+		return cur, c == 0
+	case first:
+		return cur, !l.less(v, cur.val)
+	default:
+		return cur, !l.less(cur.val, v)
+	}
 }
 
-func (l *List) jump(cur *El, v interface{} /* val */, first, upd bool) (next *El) {
+// find returns the last element less than v if first or the last not greater than v overwise
+// and its position (1-based).
+// It returns &l.zero and 0 if there is no such element.
+// If upd is set l.up and l.pos are filled with the last visited element at each level.
+// If list has CompareFunc the last comparison result of the searched element with v is returned,
+// the searched element is the next after returned if first and returned one overwise.
+func (l *List[T]) find(v T, first, upd bool) (cur *El[T], pos, c int) {
+	cur = &l.zero
+
 	for i := cur.height() - 1; i >= 0; i-- {
-		n := cur.nexti(i)
-		if n == nil {
-			continue
-		}
-		if first {
-			if l.less(n.val, v) {
-				next = n
+		for {
+			n := cur.nexti(i)
+			if n == nil {
 				break
 			}
-		} else {
-			if !l.less(v, n.val) {
-				next = n
+
+			if l.cmp != nil {
+				nc := l.cmp(n.val, v)
+
+				if first && nc >= 0 || !first && nc > 0 {
+					if first {
+						c = nc
+					}
+
+					break
+				}
+
+				if !first {
+					c = nc
+				}
+			} else if first && !l.less(n.val, v) || !first && l.less(v, n.val) {
 				break
 			}
+
+			pos += n.link(i).dist
+			cur = n
 		}
-	}
-	if upd {
-		var nh int
-		if next != nil {
-			nh = next.height()
-		}
-		for i := nh; i < cur.height(); i++ {
-			l.up[i] = cur.nextiaddr(i)
+
+		if upd {
+			l.up[i] = cur
+			l.pos[i] = pos
 		}
 	}
-	return next
+
+	return cur, pos, c
 }
 
-func (l *List) rndEl(v interface{} /* val */) *El {
-	h := l.rndHeight()
+// rndEl inserts new element after l.up elements.
+func (l *List[T]) rndEl(v T) *El[T] {
+	return l.insert(v, l.rndHeight(), l.zero.height())
+}
 
+// insert inserts new element of height h after l.up elements.
+// l.up and l.pos are only valid below top level (h <= top), pos may be relative.
+// Upper level elements following new one are found by walking forward.
+func (l *List[T]) insert(v T, h, top int) *El[T] {
 	l.len++
 
-	e := pool.Get().(*El)
+	e := l.alloc.Alloc(l.towerHeight(h))
 	e.h = h
 	e.val = v
-	if h > FixedHeight {
-		e.more = make([]*El, h-FixedHeight)
+
+	if l.metrics.Put != nil {
+		l.metrics.Put(h)
+	}
+
+	pos := l.pos[0] + 1
+
+	for i := 0; i < h; i++ {
+		pl := l.up[i].link(i)
+		el := e.link(i)
+
+		el.next = pl.next
+		el.dist = pos - l.pos[i]
+
+		if el.next != nil {
+			el.next.link(i).dist -= el.dist - 1
+		}
+
+		pl.next = e
+
+		l.setBack(el.next, i, e)
+		l.setBack(e, i, l.up[i])
 	}
 
-	for i := h - 1; i >= 0; i-- {
-		e.setnexti(i, *l.up[i])
-		*l.up[i] = e
+	for i := h; i < top; i++ {
+		n := l.up[i].nexti(i)
+		if n == nil {
+			return e
+		}
+
+		n.link(i).dist++
+	}
+
+	if top < l.zero.height() {
+		l.shift(l.up[top-1].nexti(top-1), top, 1)
 	}
 
 	return e
 }
-func (l *List) rndHeight() int {
-	r := rand.Int63()
-	h := 1
-	for r&1 == 1 && h+1 < MaxHeight {
-		h++
-		r >>= 1
+
+// unlink removes element preceded by l.up elements.
+// l.up is only valid below top level (h <= top).
+func (l *List[T]) unlink(e *El[T], top int) {
+	l.len--
+
+	h := e.height()
+	for i := 0; i < h; i++ {
+		el := e.link(i)
+
+		if el.next != nil {
+			el.next.link(i).dist += el.dist - 1
+		}
+
+		l.up[i].link(i).next = el.next
+
+		l.setBack(el.next, i, l.up[i])
+	}
+
+	for i := h; i < top; i++ {
+		n := l.up[i].nexti(i)
+		if n == nil {
+			break
+		}
+
+		n.link(i).dist--
+	}
+
+	if top < l.zero.height() {
+		l.shift(e.nexti(top-1), top, -1)
+	}
+
+	l.free(e)
+}
+
+// shift adds d to distances of the first elements at levels from top up
+// following the changed place. n is the first such element at level top-1.
+func (l *List[T]) shift(n *El[T], top, d int) {
+	for i := top; i < l.zero.height(); i++ {
+		for n != nil && n.height() <= i {
+			n = n.nexti(i - 1)
+		}
+
+		if n == nil {
+			break
+		}
+
+		n.link(i).dist += d
+	}
+}
+
+// setBack sets e back link at level i if list has back links.
+func (l *List[T]) setBack(e *El[T], i int, p *El[T]) {
+	if l.backlinks && e != nil {
+		e.more[e.h-1+i].next = p
+	}
+}
+
+// towerHeight is the height allocated for element of height h.
+// Back links take h more links.
+func (l *List[T]) towerHeight(h int) int {
+	if l.backlinks {
+		return 2 * h
 	}
+
 	return h
 }
 
-func (e *El) Value() interface{} /* val */ {
-	return e.val
+// owns reports whether e is in the list following the highest back links up to the head.
+func (l *List[T]) owns(e *El[T]) bool {
+	if e.backi(0) == nil {
+		return false
+	}
+
+	for e != &l.zero {
+		i := e.height() - 1
+
+		p := e.backi(i)
+		if p == nil || p.nexti(i) != e {
+			return false
+		}
+
+		e = p
+	}
+
+	return true
 }
-func (e *El) Next() *El {
-	return e.next[0]
+
+// del unlinks e and returns its value.
+// The value is taken before e could be reused.
+func (l *List[T]) del(e *El[T], top int) T {
+	v := e.val
+
+	l.unlink(e, top)
+
+	return v
 }
-func (e *El) nexti(i int) *El {
-	if i < FixedHeight {
-		return e.next[i]
-	} else {
-		return e.more[i-FixedHeight]
+
+// free is called for each removed element.
+func (l *List[T]) free(e *El[T]) {
+	if l.metrics.Del != nil {
+		l.metrics.Del(e.height())
+	}
+
+	if l.backlinks {
+		l.setBack(e, 0, nil) // so that DelEl and Prev know it's deleted
+	}
+
+	e.next.dist = deleted
+
+	if l.autoreuse {
+		l.release(e)
 	}
 }
-func (e *El) setnexti(i int, v *El) {
-	if i < FixedHeight {
-		e.next[i] = v
-	} else {
-		e.more[i-FixedHeight] = v
+
+// Release gives deleted element back to the list allocator for reuse.
+// It's the way to reuse elements when auto reuse is disabled.
+// e must be deleted from the list by Del, DelRange, Pop or similar method
+// and must not be used by the caller after that.
+// It panics if e is not deleted or is released already.
+// Elements dropped by Clear(false) are not deleted one by one and are left for GC.
+// With skiplistdebug build tag released elements are poisoned instead
+// and any later access to them panics.
+func (l *List[T]) Release(e *El[T]) {
+	if l.autoreuse {
+		panic("skiplist: release with auto reuse enabled")
 	}
+
+	if debug {
+		e.check()
+	}
+
+	if e.next.dist != deleted {
+		panic("skiplist: release of not deleted element")
+	}
+
+	e.next.dist = 0
+
+	l.release(e)
 }
-func (e *El) nextiaddr(i int) **El {
-	if i < FixedHeight {
-		return &e.next[i]
-	} else {
-		return &e.more[i-FixedHeight]
+
+func (l *List[T]) release(e *El[T]) {
+	if debug {
+		e.check()
+		e.poison()
+
+		return
 	}
+
+	l.alloc.Free(e)
+}
+
+func (e *El[T]) Value() T {
+	if debug {
+		e.check()
+	}
+
+	return e.val
 }
-func (e *El) height() int {
+func (e *El[T]) Next() *El[T] {
+	if debug {
+		e.check()
+	}
+
+	return e.next.next
+}
+func (e *El[T]) nexti(i int) *El[T] {
+	return e.link(i).next
+}
+func (e *El[T]) link(i int) *link[T] {
+	if i == 0 {
+		return &e.next
+	}
+
+	return &e.more[i-1]
+}
+
+// backi returns previous element at level i. List must have back links.
+func (e *El[T]) backi(i int) *El[T] {
+	return e.more[e.h-1+i].next
+}
+func (e *El[T]) height() int {
 	return e.h
 }
 
-func (l *List) String() string {
+func (l *List[T]) String() string {
 	var buf bytes.Buffer
 	for z := &l.zero; z != nil; z = z.Next() {
 		_, _ = buf.WriteString(z.String())
@@ -302,7 +662,7 @@ func (l *List) String() string {
 	}
 	return buf.String()
 }
-func (e *El) String() string {
+func (e *El[T]) String() string {
 	var buf bytes.Buffer
 	_, _ = fmt.Fprintf(&buf, "%-10v: (%d)", fmt.Sprint(e.val), e.height())
 	for i := 0; i < e.height(); i++ {
@@ -316,8 +676,17 @@ func (e *El) String() string {
 	return buf.String()
 }
 
-// Put element to buffer for later usage
-func Reuse(cur *El) {
-	cur.more = nil
-	pool.Put(cur)
+// Reuse puts element deleted from a list with auto reuse disabled to the package global pool.
+// The same rules as for List.Release apply.
+//
+// Deprecated: lists don't use the global pool by default anymore. Use List.Release.
+func Reuse[T any](cur *El[T]) {
+	if debug {
+		cur.check()
+		cur.poison()
+
+		return
+	}
+
+	poolAlloc[T]{p: &pool}.Free(cur)
 }
@@ -7,18 +7,13 @@ import (
 )
 
 func TestRepeatedOrder(t *testing.T) {
-	defer func(v int) {
-		MaxHeight = v
-	}(MaxHeight)
-	MaxHeight = 4
-
 	type El struct {
 		k int
 		n int
 	}
-	l := NewRepeated(func(a, b interface{}) bool {
-		return a.(El).k < b.(El).k
-	})
+	l := NewRepeated(func(a, b El) bool {
+		return a.k < b.k
+	}, WithMaxHeight(4))
 
 	p1, _ := l.Put(El{k: 4, n: 1})
 	assert.NotNil(t, p1)
@@ -35,27 +30,27 @@ func TestRepeatedOrder(t *testing.T) {
 	}
 
 	g1 := l.Get(El{k: 4})
-	if g1 == nil || g1.Value() == nil || g1.Value().(El).n != 1 {
+	if g1 == nil || g1.Value().n != 1 {
 		t.Fatalf("get: %v", g1)
 	}
 
-	d1 := l.Del(El{k: 4})
-	if d1 == nil || d1.Value() == nil || d1.Value().(El).n != 1 {
+	d1, ok := l.Del(El{k: 4})
+	if !ok || d1.n != 1 {
 		t.Fatalf("del: %v", d1)
 	}
 
-	d2 := l.Del(El{k: 4})
-	if d2 == nil || d2.Value() == nil || d2.Value().(El).n != 2 {
+	d2, ok := l.Del(El{k: 4})
+	if !ok || d2.n != 2 {
 		t.Fatalf("del: %v", d2)
 	}
 
-	d3 := l.Del(El{k: 4})
-	if d3 == nil || d3.Value() == nil || d3.Value().(El).n != 3 {
+	d3, ok := l.Del(El{k: 4})
+	if !ok || d3.n != 3 {
 		t.Fatalf("del: %v", d3)
 	}
 
 	f := l.First()
-	if f == nil || f.Value() == nil || f.Value().(El).k != 2 {
+	if f == nil || f.Value().k != 2 {
 		t.Fatalf("first: %v", g1)
 	}
 
@@ -63,17 +58,15 @@ func TestRepeatedOrder(t *testing.T) {
 }
 
 func TestDelIf(t *testing.T) {
-	t.Logf("MaxHeight: %v", MaxHeight)
-
 	type Elt struct {
 		k int
 		n int
 	}
-	l := NewRepeated(func(a, b interface{}) bool {
-		return a.(Elt).k < b.(Elt).k
+	l := NewRepeated(func(a, b Elt) bool {
+		return a.k < b.k
 	})
 
-	var del *El
+	var del *El[Elt]
 	for i := 0; i < 10; i++ {
 		q, _ := l.Put(Elt{k: 1, n: i})
 		if i == 4 {
@@ -83,8 +76,8 @@ func TestDelIf(t *testing.T) {
 
 	t.Logf("\n%v", l)
 
-	l.DelIf(Elt{k: 1}, func(b *El) bool {
-		et := b.Value().(Elt)
+	l.DelIf(Elt{k: 1}, func(b *El[Elt]) bool {
+		et := b.Value()
 		return et.n == 2
 	})
 	l.DelEl(del)
@@ -94,16 +87,18 @@ func TestDelIf(t *testing.T) {
 		if i == 2 || i == 4 {
 			i++
 		}
-		assert.Equal(t, i, e.Value().(Elt).n)
+		assert.Equal(t, i, e.Value().n)
 		i++
 	}
 
 	t.Logf("\n%v", l)
 
+	checkDist(t, l)
+
 	l.Put(Elt{k: 10})
 
-	l.DelIf(Elt{k: 1}, func(b *El) bool {
-		et := b.Value().(Elt)
+	l.DelIf(Elt{k: 1}, func(b *El[Elt]) bool {
+		et := b.Value()
 		assert.Equal(t, 1, et.k, "stepped over requested element")
 		return et.n == 2
 	})
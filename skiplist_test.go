@@ -1,7 +1,12 @@
 package skiplist
 
 import (
+	"cmp"
+	"flag"
+	"fmt"
 	"math/rand"
+	"os"
+	"slices"
 	"testing"
 	"time"
 	"unsafe"
@@ -9,23 +14,37 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func init() {
-	rand.Seed(time.Now().UnixNano())
+var (
+	seed = flag.Int64("seed", 0, "random seed for tests, time based if 0")
+	rnd  *rand.Rand
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+
+	rnd = rand.New(rand.NewSource(*seed))
+
+	code := m.Run()
+
+	if code != 0 || testing.Verbose() {
+		fmt.Printf("seed: %d\n", *seed) // to reproduce with -seed
+	}
+
+	os.Exit(code)
 }
 
 func TestPutGet(t *testing.T) {
-	defer func(v int) {
-		MaxHeight = v
-	}(MaxHeight)
-	MaxHeight = 5
-
-	l := New(IntLess)
+	l := New(IntLess, WithMaxHeight(5))
 
 	t.Logf("init:\n%v", l)
 
 	for _, i := range []int{1, 5, 9, 3, 7, 0} {
 		cur, add := l.Put(i)
-		if !add || cur.Value() == nil || cur.Value().(int) != i {
+		if !add || cur.Value() != i {
 			t.Errorf("not added: %v %v", i, cur)
 		}
 	}
@@ -38,7 +57,7 @@ func TestPutGet(t *testing.T) {
 
 	for _, i := range []int{1, 5, 9, 3, 7, 0} {
 		cur, add := l.Put(i)
-		if add || cur.Value() == nil || cur.Value().(int) != i {
+		if add || cur.Value() != i {
 			t.Errorf("added: %v", i)
 		}
 	}
@@ -55,7 +74,7 @@ func TestPutGet(t *testing.T) {
 			t.Errorf("Get: %v want %v", el, i)
 			continue
 		}
-		if val, ok := el.Value().(int); !ok || val != i {
+		if el.Value() != i {
 			t.Errorf("Get: %v want %v", el, i)
 			continue
 		}
@@ -70,13 +89,13 @@ func TestPutGet(t *testing.T) {
 
 	exp := []int{0, 1, 3, 5, 7, 9}
 	i := 0
-	var prev *El
+	var prev *El[int]
 	for e := l.First(); e != nil; e = e.Next() {
 		if e == prev {
 			t.Errorf("got after self: %v", e)
 			break
 		}
-		if i >= len(exp) || e.Value() == nil || exp[i] != e.Value().(int) {
+		if i >= len(exp) || exp[i] != e.Value() {
 			var e int
 			if i < len(exp) {
 				e = exp[i]
@@ -90,9 +109,9 @@ func TestPutGet(t *testing.T) {
 		t.Errorf("short list: %d", i)
 	}
 
-	cur := l.Del(3)
-	if cur == nil {
-		t.Errorf("%d should be deleted, buf %v", 3, cur)
+	_, ok := l.Del(3)
+	if !ok {
+		t.Errorf("%d should be deleted", 3)
 	}
 
 	t.Logf("del 3\n%v", l)
@@ -101,9 +120,9 @@ func TestPutGet(t *testing.T) {
 		t.Errorf("Len: %v", l.Len())
 	}
 
-	cur = l.Del(3)
-	if cur != nil {
-		t.Errorf("%d already deleted, buf %v", 3, cur)
+	_, ok = l.Del(3)
+	if ok {
+		t.Errorf("%d already deleted", 3)
 	}
 
 	t.Logf("del 3 again\n%v", l)
@@ -116,9 +135,9 @@ func TestPutGet(t *testing.T) {
 		if e == 3 {
 			continue
 		}
-		cur = l.Del(e)
-		if cur == nil {
-			t.Errorf("should be deleted, buf %v", cur)
+		_, ok = l.Del(e)
+		if !ok {
+			t.Errorf("should be deleted: %v", e)
 		}
 	}
 
@@ -129,9 +148,9 @@ func TestPutGet(t *testing.T) {
 	}
 
 	for _, e := range exp {
-		cur = l.Del(e)
-		if cur != nil {
-			t.Errorf("already deleted buf %v", cur)
+		_, ok = l.Del(e)
+		if ok {
+			t.Errorf("already deleted: %v", e)
 		}
 	}
 }
@@ -151,7 +170,7 @@ func TestPutRepeats(t *testing.T) {
 	exp := []int{2, 2, 4, 4, 4, 4}
 	i := 0
 	for e := l.First(); e != nil; e = e.Next() {
-		if exp[i] != e.Value().(int) {
+		if exp[i] != e.Value() {
 			t.Errorf("Get: %v want %v", e, exp[i])
 		}
 		i++
@@ -179,7 +198,68 @@ func TestPutRepeats(t *testing.T) {
 }
 
 func TestHeight(t *testing.T) {
-	l := New(nil)
+	testHeight(t, New[int](nil), 0.5)
+}
+
+func TestHeightP(t *testing.T) {
+	for _, p := range []float64{0.25, 1. / 3, 0.125} {
+		l := New[int](nil, WithP(p), WithRand(rand.NewSource(1)))
+
+		t.Run(fmt.Sprintf("%.3f", p), func(t *testing.T) {
+			testHeight(t, l, p)
+		})
+	}
+}
+
+func TestSeed(t *testing.T) {
+	a := New(IntLess, WithSeed(*seed))
+	b := New(IntLess, WithSeed(*seed))
+
+	for i := 0; i < 1000; i++ {
+		v := rnd.Intn(300)
+
+		a.Put(v)
+		b.Put(v)
+
+		if i%3 == 0 {
+			a.Del(v / 2)
+			b.Del(v / 2)
+		}
+	}
+
+	assert.Equal(t, a.String(), b.String())
+
+	c := New(IntLess, WithSeed(*seed+1))
+
+	for e := a.First(); e != nil; e = e.Next() {
+		c.Put(e.Value())
+	}
+
+	assert.NotEqual(t, a.String(), c.String())
+}
+
+func TestMaxHeight(t *testing.T) {
+	for _, h := range []int{1, 3, 5, 40} {
+		l := New(IntLess, WithMaxHeight(h), WithP(0.75))
+
+		for i := 0; i < 1000; i++ {
+			l.Put(i)
+		}
+
+		max := 0
+		for e := l.First(); e != nil; e = e.Next() {
+			if e.height() > max {
+				max = e.height()
+			}
+		}
+
+		assert.Equal(t, h, l.zero.height())
+		assert.True(t, max <= h, "max height %d > %d", max, h)
+		checkDist(t, l)
+	}
+}
+
+func testHeight[T any](t *testing.T, l *List[T], P float64) {
 	const D = 1.8
 	const Min = 50
 
@@ -203,12 +283,12 @@ func TestHeight(t *testing.T) {
 				break
 			}
 		}
-		p := 0.5
-		if i != 0 {
+		p := P
+		if i > 1 {
 			p = float64(v) / float64(hist[i-1])
 		}
-		if v > Min && (p > 0.5*D || p < 0.5/D) {
-			t.Errorf("i %2d: %7v (%.2f)  <- out of (%.3v %.3v)", i, v, p, 0.5/D, 0.5*D)
+		if v > Min && (p > P*D || p < P/D) {
+			t.Errorf("i %2d: %7v (%.2f)  <- out of (%.3v %.3v)", i, v, p, P/D, P*D)
 		} else {
 			t.Logf("i %2d: %7v (%.2f)", i, v, p)
 		}
@@ -217,13 +297,13 @@ func TestHeight(t *testing.T) {
 
 func TestRandom(t *testing.T) {
 	const M = 10000
-	l := New(IntLess)
+	l := New(IntLess, WithSeed(*seed))
 
 	add := make(map[int]struct{})
 	del := make(map[int]struct{})
 
 	for i := 0; i < M; i++ {
-		v := rand.Intn(M)
+		v := rnd.Intn(M)
 		add[v] = struct{}{}
 		l.Put(v)
 	}
@@ -231,7 +311,7 @@ func TestRandom(t *testing.T) {
 		t.Errorf("Len expected %d, have %d", len(add), l.Len())
 	}
 	for i := 0; i < M*6/10; i++ {
-		v := rand.Intn(M)
+		v := rnd.Intn(M)
 		del[v] = struct{}{}
 		l.Del(v)
 	}
@@ -243,7 +323,7 @@ func TestRandom(t *testing.T) {
 			continue
 		}
 
-		if el := l.Get(v); el == nil || el.Value() == nil || el.Value().(int) != v {
+		if el := l.Get(v); el == nil || el.Value() != v {
 			t.Errorf("want %d, have %v", v, el)
 		}
 	}
@@ -256,18 +336,82 @@ func TestRandom(t *testing.T) {
 			t.Errorf("want %v, have %v", nil, el)
 		}
 	}
+
+	checkDist(t, l)
+}
+
+func TestCompare(t *testing.T) {
+	const M = 10000
+
+	var calls int
+	l := NewCompare(func(a, b int) int {
+		calls++
+		return cmp.Compare(a, b)
+	}, WithRepeat(true), WithSeed(*seed))
+
+	exp := make(map[int]int)
+
+	for i := 0; i < M; i++ {
+		v := rnd.Intn(M / 2)
+
+		switch rnd.Intn(3) {
+		case 0:
+			if _, ok := l.Del(v); ok {
+				exp[v]--
+			}
+		case 1:
+			l.PutBefore(v)
+			exp[v]++
+		default:
+			l.Put(v)
+			exp[v]++
+		}
+	}
+
+	n := 0
+	for v, cnt := range exp {
+		n += cnt
+
+		if cnt == 0 {
+			assert.Nil(t, l.Get(v))
+			assert.Nil(t, l.GetLast(v))
+			continue
+		}
+
+		assert.Equal(t, v, l.Get(v).Value())
+		assert.Equal(t, v, l.GetLast(v).Value())
+		assert.Equal(t, cnt, l.CountRange(v, v+1))
+	}
+
+	assert.Equal(t, n, l.Len())
+	checkDist(t, l)
+
+	calls = 0
+	l.Get(M / 4)
+	t.Logf("compare calls per Get: %d", calls)
+
+	u := NewCompare(cmp.Compare[int])
+
+	for _, v := range []int{3, 1, 2, 1} {
+		u.Put(v)
+	}
+
+	e, added := u.GetOrPut(2)
+	assert.False(t, added)
+	assert.Equal(t, 2, e.Value())
+	assert.Equal(t, 3, u.Len())
 }
 
 func TestRandomRepeated(t *testing.T) {
 	const M = 10000
-	l := NewRepeated(IntGreater)
-	l2 := NewRepeated(IntGreater)
+	l := NewRepeated(IntGreater, WithSeed(*seed))
+	l2 := NewRepeated(IntGreater, WithSeed(*seed))
 
 	add := make(map[int]int)
 	del := make(map[int]int)
 
 	for i := 0; i < M; i++ {
-		v := rand.Intn(M)
+		v := rnd.Intn(M)
 		add[v]++
 		l.Put(v)
 		l2.GetOrPut(v)
@@ -279,7 +423,7 @@ func TestRandomRepeated(t *testing.T) {
 		t.Errorf("l2.Len expected %d, have %d", len(add), l2.Len())
 	}
 	for i := 0; i < M*6/10; i++ {
-		v := rand.Intn(M)
+		v := rnd.Intn(M)
 		del[v]++
 		l.Del(v)
 		l2.Del(v)
@@ -310,7 +454,7 @@ func TestRandomRepeated(t *testing.T) {
 				t.Errorf("want %v, have %v", nil, el)
 			}
 		} else {
-			if el := l.Get(v); el == nil || el.Value() == nil || el.Value().(int) != v {
+			if el := l.Get(v); el == nil || el.Value() != v {
 				t.Errorf("want %d, have %v", v, el)
 			}
 		}
@@ -319,7 +463,7 @@ func TestRandomRepeated(t *testing.T) {
 				t.Errorf("want %v, have %v", nil, el)
 			}
 		} else {
-			if el := l2.Get(v); el == nil || el.Value() == nil || el.Value().(int) != v {
+			if el := l2.Get(v); el == nil || el.Value() != v {
 				t.Errorf("want %d, have %v", v, el)
 			}
 		}
@@ -330,6 +474,9 @@ func TestRandomRepeated(t *testing.T) {
 	if l2.Len() != diff2 {
 		t.Errorf("Len expected %d, have %d", diff2, l2.Len())
 	}
+
+	checkDist(t, l)
+	checkDist(t, l2)
 }
 
 func TestGetOrPut(t *testing.T) {
@@ -367,7 +514,7 @@ func TestRepeated(t *testing.T) {
 
 	l := NewRepeated(IntGreater)
 
-	p := make([]*El, N)
+	p := make([]*El[int], N)
 	for i := 0; i < N; i++ {
 		p[i], _ = l.PutBefore(1)
 	}
@@ -380,9 +527,8 @@ func TestRepeated(t *testing.T) {
 			t.Errorf("got not the last")
 		}
 
-		del := l.DelEl(g)
-		if del != g {
-			t.Errorf("deleted not requested")
+		if !l.DelEl(g) {
+			t.Errorf("not deleted")
 		}
 	}
 
@@ -390,22 +536,18 @@ func TestRepeated(t *testing.T) {
 
 	assert.Nil(t, l.GetLast(1))
 
-	assert.Nil(t, l.DelIf(1, func(*El) bool { return true }))
+	_, ok := l.DelIf(1, func(*El[int]) bool { return true })
+	assert.False(t, ok)
 }
 
 func TestPutBeforeGetLast(t *testing.T) {
-	defer func(v int) {
-		MaxHeight = v
-	}(MaxHeight)
-	MaxHeight = 5
-
-	l := New(IntLess)
+	l := New(IntLess, WithMaxHeight(5))
 
 	t.Logf("init:\n%v", l)
 
 	for _, i := range []int{1, 5, 9, 3, 7, 0} {
 		cur, add := l.PutBefore(i)
-		if !add || cur.Value() == nil || cur.Value().(int) != i {
+		if !add || cur.Value() != i {
 			t.Errorf("not added: %v %v", i, cur)
 		}
 	}
@@ -418,7 +560,7 @@ func TestPutBeforeGetLast(t *testing.T) {
 
 	for _, i := range []int{1, 5, 9, 3, 7, 0} {
 		cur, add := l.PutBefore(i)
-		if add || cur.Value() == nil || cur.Value().(int) != i {
+		if add || cur.Value() != i {
 			t.Errorf("added: %v", i)
 		}
 	}
@@ -435,7 +577,7 @@ func TestPutBeforeGetLast(t *testing.T) {
 			t.Errorf("GetLast: %v want %v", el, i)
 			continue
 		}
-		if val, ok := el.Value().(int); !ok || val != i {
+		if el.Value() != i {
 			t.Errorf("GetLast: %v want %v", el, i)
 			continue
 		}
@@ -450,13 +592,13 @@ func TestPutBeforeGetLast(t *testing.T) {
 
 	exp := []int{0, 1, 3, 5, 7, 9}
 	i := 0
-	var prev *El
+	var prev *El[int]
 	for e := l.First(); e != nil; e = e.Next() {
 		if e == prev {
 			t.Errorf("got after self: %v", e)
 			break
 		}
-		if i >= len(exp) || e.Value() == nil || exp[i] != e.Value().(int) {
+		if i >= len(exp) || exp[i] != e.Value() {
 			var e int
 			if i < len(exp) {
 				e = exp[i]
@@ -470,9 +612,9 @@ func TestPutBeforeGetLast(t *testing.T) {
 		t.Errorf("short list: %d", i)
 	}
 
-	cur := l.Del(3)
-	if cur == nil {
-		t.Errorf("%d should be deleted, buf %v", 3, cur)
+	_, ok := l.Del(3)
+	if !ok {
+		t.Errorf("%d should be deleted", 3)
 	}
 
 	t.Logf("del 3\n%v", l)
@@ -481,9 +623,9 @@ func TestPutBeforeGetLast(t *testing.T) {
 		t.Errorf("Len: %v", l.Len())
 	}
 
-	cur = l.Del(3)
-	if cur != nil {
-		t.Errorf("%d already deleted, buf %v", 3, cur)
+	_, ok = l.Del(3)
+	if ok {
+		t.Errorf("%d already deleted", 3)
 	}
 
 	t.Logf("del 3 again\n%v", l)
@@ -496,9 +638,9 @@ func TestPutBeforeGetLast(t *testing.T) {
 		if e == 3 {
 			continue
 		}
-		cur = l.Del(e)
-		if cur == nil {
-			t.Errorf("should be deleted, buf %v", cur)
+		_, ok = l.Del(e)
+		if !ok {
+			t.Errorf("should be deleted: %v", e)
 		}
 	}
 
@@ -509,27 +651,24 @@ func TestPutBeforeGetLast(t *testing.T) {
 	}
 
 	for _, e := range exp {
-		cur = l.Del(e)
-		if cur != nil {
-			t.Errorf("already deleted buf %v", cur)
+		_, ok = l.Del(e)
+		if ok {
+			t.Errorf("already deleted: %v", e)
 		}
 	}
 }
 
 func TestCoverSetAutoReuse(t *testing.T) {
-	defer func(v int) {
-		MaxHeight = v
-	}(MaxHeight)
-	MaxHeight = 5
+	l := New(IntLess, WithMaxHeight(5))
+	l.SetAutoReuse(true)
 
-	l := New(IntLess)
-	l.SetAutoReuse(false)
+	assert.True(t, l.autoreuse)
 
 	t.Logf("init:\n%v", l)
 
 	for _, i := range []int{1, 5, 9, 3, 7, 0} {
 		cur, add := l.Put(i)
-		if !add || cur.Value() == nil || cur.Value().(int) != i {
+		if !add || cur.Value() != i {
 			t.Errorf("not added: %v %v", i, cur)
 		}
 	}
@@ -542,7 +681,7 @@ func TestCoverSetAutoReuse(t *testing.T) {
 
 	for _, i := range []int{1, 5, 9, 3, 7, 0} {
 		cur, add := l.Put(i)
-		if add || cur.Value() == nil || cur.Value().(int) != i {
+		if add || cur.Value() != i {
 			t.Errorf("added: %v", i)
 		}
 	}
@@ -559,7 +698,7 @@ func TestCoverSetAutoReuse(t *testing.T) {
 			t.Errorf("Get: %v want %v", el, i)
 			continue
 		}
-		if val, ok := el.Value().(int); !ok || val != i {
+		if el.Value() != i {
 			t.Errorf("Get: %v want %v", el, i)
 			continue
 		}
@@ -574,13 +713,13 @@ func TestCoverSetAutoReuse(t *testing.T) {
 
 	exp := []int{0, 1, 3, 5, 7, 9}
 	i := 0
-	var prev *El
+	var prev *El[int]
 	for e := l.First(); e != nil; e = e.Next() {
 		if e == prev {
 			t.Errorf("got after self: %v", e)
 			break
 		}
-		if i >= len(exp) || e.Value() == nil || exp[i] != e.Value().(int) {
+		if i >= len(exp) || exp[i] != e.Value() {
 			var e int
 			if i < len(exp) {
 				e = exp[i]
@@ -594,9 +733,9 @@ func TestCoverSetAutoReuse(t *testing.T) {
 		t.Errorf("short list: %d", i)
 	}
 
-	cur := l.Del(3)
-	if cur == nil {
-		t.Errorf("%d should be deleted, buf %v", 3, cur)
+	_, ok := l.Del(3)
+	if !ok {
+		t.Errorf("%d should be deleted", 3)
 	}
 
 	t.Logf("del 3\n%v", l)
@@ -605,9 +744,9 @@ func TestCoverSetAutoReuse(t *testing.T) {
 		t.Errorf("Len: %v", l.Len())
 	}
 
-	cur = l.Del(3)
-	if cur != nil {
-		t.Errorf("%d already deleted, buf %v", 3, cur)
+	_, ok = l.Del(3)
+	if ok {
+		t.Errorf("%d already deleted", 3)
 	}
 
 	t.Logf("del 3 again\n%v", l)
@@ -620,9 +759,9 @@ func TestCoverSetAutoReuse(t *testing.T) {
 		if e == 3 {
 			continue
 		}
-		cur = l.Del(e)
-		if cur == nil {
-			t.Errorf("should be deleted, buf %v", cur)
+		_, ok = l.Del(e)
+		if !ok {
+			t.Errorf("should be deleted: %v", e)
 		}
 	}
 
@@ -633,9 +772,9 @@ func TestCoverSetAutoReuse(t *testing.T) {
 	}
 
 	for _, e := range exp {
-		cur = l.Del(e)
-		if cur != nil {
-			t.Errorf("already deleted buf %v", cur)
+		_, ok = l.Del(e)
+		if ok {
+			t.Errorf("already deleted: %v", e)
 		}
 	}
 }
@@ -693,9 +832,239 @@ func BenchmarkAddNewRepeated(b *testing.B) {
 }
 
 func TestSizeof(t *testing.T) {
-	var l List
-	var el El
+	var l List[int]
+	var el El[int]
 
 	t.Logf("sizeof list: %d", unsafe.Sizeof(l))
 	t.Logf("sizeof element: %d", unsafe.Sizeof(el))
+	t.Logf("sizeof element with links: 2: %d  3: %d  fixed: %d", unsafe.Sizeof(el2[int]{}), unsafe.Sizeof(el3[int]{}), unsafe.Sizeof(elFixed[int]{}))
+}
+
+func TestLast(t *testing.T) {
+	l := New(IntLess)
+
+	assert.Nil(t, l.Last())
+
+	for i := 0; i < 1000; i++ {
+		l.Put(rnd.Intn(10000))
+
+		assert.True(t, l.Last() == l.GetLast(l.Last().Value()))
+		assert.Nil(t, l.Last().Next())
+	}
+}
+
+func BenchmarkRndHeight(b *testing.B) {
+	b.ReportAllocs()
+
+	l := New(IntLess)
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = l.rndHeight()
+		}
+	})
+}
+
+func TestNeighbors(t *testing.T) {
+	l := NewRepeated(IntLess)
+
+	val := func(e *El[int]) int {
+		if e == nil {
+			return -100
+		}
+
+		return e.Value()
+	}
+
+	assert.Nil(t, l.Floor(1))
+	assert.Nil(t, l.Ceil(1))
+
+	var twos []*El[int]
+	for _, v := range []int{0, 2, 2, 4} {
+		e, _ := l.Put(v)
+
+		if v == 2 {
+			twos = append(twos, e)
+		}
+	}
+
+	for _, tc := range []struct {
+		v                          int
+		floor, ceil, lower, higher int
+	}{
+		{-1, -100, 0, -100, 0},
+		{0, 0, 0, -100, 2},
+		{1, 0, 2, 0, 2},
+		{2, 2, 2, 0, 4},
+		{3, 2, 4, 2, 4},
+		{4, 4, 4, 2, -100},
+		{5, 4, -100, 4, -100},
+	} {
+		assert.Equal(t, tc.floor, val(l.Floor(tc.v)), "floor %v", tc.v)
+		assert.Equal(t, tc.ceil, val(l.Ceil(tc.v)), "ceil %v", tc.v)
+		assert.Equal(t, tc.lower, val(l.Lower(tc.v)), "lower %v", tc.v)
+		assert.Equal(t, tc.higher, val(l.Higher(tc.v)), "higher %v", tc.v)
+	}
+
+	assert.True(t, l.Floor(2) == twos[1])
+	assert.True(t, l.Ceil(2) == twos[0])
+}
+
+func TestBackLinks(t *testing.T) {
+	const N = 2000
+
+	l := NewRepeated(IntLess, WithBackLinks(true), WithSeed(*seed))
+	var els []*El[int]
+
+	for i := 0; i < N; i++ {
+		e, _ := l.Put(rnd.Intn(N / 10))
+		els = append(els, e)
+	}
+
+	checkDist(t, l)
+
+	rnd.Shuffle(len(els), func(i, j int) { els[i], els[j] = els[j], els[i] })
+
+	for i, e := range els[:N/2] {
+		v := e.Value()
+
+		if p := l.Prev(e); p != nil {
+			assert.True(t, p.Next() == e)
+		}
+
+		assert.True(t, l.DelEl(e))
+
+		if i%100 == 0 {
+			checkDist(t, l)
+		}
+
+		if rnd.Intn(2) == 0 {
+			l.Put(v)
+		}
+	}
+
+	checkDist(t, l)
+
+	l.DelRange(10, 20, nil)
+	l.PopWhile(func(v int) bool { return v < 5 })
+	l.PopLast()
+	checkDist(t, l)
+
+	l, r := l.SplitAt(100)
+	checkDist(t, l)
+	checkDist(t, r)
+
+	m := NewRepeated(IntLess, WithBackLinks(true), WithSeed(*seed))
+	for i := 0; i < N/10; i++ {
+		m.Put(rnd.Intn(100))
+	}
+
+	l = Merge(l, m)
+	checkDist(t, l)
+
+	l = Concat(l, r)
+	checkDist(t, l)
+
+	exp := slices.Collect(l.All())
+	slices.Reverse(exp)
+	assert.Equal(t, exp, slices.Collect(l.Backward()))
+
+	assert.Panics(t, func() { Concat(l, NewRepeated(IntLess)) })
+}
+
+func TestBackLinksDelElTwice(t *testing.T) {
+	l := New(IntLess, WithBackLinks(true), WithSeed(*seed))
+
+	for i := 0; i < 5; i++ {
+		l.Put(i)
+	}
+
+	e := l.Get(2)
+
+	assert.True(t, l.DelEl(e))
+
+	l.Put(2)
+
+	assert.False(t, l.DelEl(e))
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, slices.Collect(l.All()))
+	checkDist(t, l)
+}
+
+func TestDelMissZero(t *testing.T) {
+	l := New(IntLess)
+	l.Put(1)
+
+	v, ok := l.Del(5)
+	assert.False(t, ok)
+	assert.Equal(t, 0, v)
+
+	v, ok = l.DelIf(5, func(*El[int]) bool { return true })
+	assert.False(t, ok)
+	assert.Equal(t, 0, v)
+
+	p := NewPersistent(IntLess)
+	p.Put(1)
+
+	v, ok = p.Get(5)
+	assert.False(t, ok)
+	assert.Equal(t, 0, v)
+
+	v, ok = p.Del(5)
+	assert.False(t, ok)
+	assert.Equal(t, 0, v)
+}
+
+func TestBackLinksDetached(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		alloc Option
+	}{
+		{"pool", WithLayout(CompactLayout)},
+		{"arena", WithAllocator[int](NewArena[int](4))},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mk := func() *List[int] {
+				l := New(IntLess, WithBackLinks(true), tc.alloc, WithSeed(*seed))
+
+				for i := 0; i < 5; i++ {
+					l.Put(i)
+				}
+
+				return l
+			}
+
+			l := mk()
+			e := l.Get(3)
+
+			l.Clear(false)
+
+			assert.False(t, l.DelEl(e))
+			assert.Equal(t, 0, l.Len())
+
+			l = mk()
+			e = l.Get(3)
+
+			l, r := l.SplitAt(2)
+
+			assert.False(t, l.DelEl(e))
+			assert.Equal(t, 2, l.Len())
+			assert.True(t, r.DelEl(e))
+			assert.Equal(t, []int{2, 4}, slices.Collect(r.All()))
+			checkDist(t, r)
+
+			l = mk()
+			e = l.Get(3)
+
+			assert.NotNil(t, l.Prev(e))
+			assert.True(t, l.DelEl(e))
+
+			assert.Nil(t, l.Prev(e))
+
+			for i := 10; i < 100; i++ {
+				l.Put(i)
+			}
+
+			checkDist(t, l)
+		})
+	}
 }
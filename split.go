@@ -0,0 +1,159 @@
+package skiplist
+
+// SplitAt moves elements not less than v to the new right list in O(log n).
+// l keeps the rest and is returned as left.
+func (l *List[T]) SplitAt(v T) (left, right *List[T]) {
+	right = l.empty()
+
+	l.find(v, true, true)
+
+	k := l.pos[0]
+
+	for i := 0; i < l.zero.height(); i++ {
+		pl := l.up[i].link(i)
+		if pl.next == nil {
+			break
+		}
+
+		rl := right.zero.link(i)
+
+		rl.next = pl.next
+		rl.next.link(i).dist += l.pos[i] - k
+		right.setBack(rl.next, i, &right.zero)
+
+		pl.next = nil
+	}
+
+	right.len = l.len - k
+	l.len = k
+
+	return l, right
+}
+
+// Concat appends all b elements to a and returns a. b becomes empty.
+// All a elements must be less than (or not greater if repeated) b ones.
+// It's O(log n), lists must have the same max height.
+// b can't be repeated if a is not.
+func Concat[T any](a, b *List[T]) *List[T] {
+	checkJoin(a, b)
+
+	if b.len == 0 {
+		return a
+	}
+
+	if al, bf := a.Last(), b.First(); al != nil && (a.less(bf.val, al.val) || !a.repeat && !a.less(al.val, bf.val)) {
+		panic("skiplist: concat of overlapping lists")
+	}
+
+	a.tail()
+
+	for i := 0; i < b.zero.height(); i++ {
+		bl := b.zero.link(i)
+		if bl.next == nil {
+			break
+		}
+
+		n := bl.next
+		n.link(i).dist += a.len - a.pos[i]
+
+		a.up[i].link(i).next = n
+		a.setBack(n, i, a.up[i])
+	}
+
+	a.len += b.len
+	b.clear()
+
+	return a
+}
+
+// Merge moves all b elements to a keeping order and returns a. b becomes empty.
+// Elements are relinked, not reallocated, in O(n + m).
+// Repeated elements of b are placed after equal elements of a.
+// If a is not repeated, b elements equal to a ones are deleted.
+// Lists must have the same max height, b can't be repeated if a is not.
+func Merge[T any](a, b *List[T]) *List[T] {
+	checkJoin(a, b)
+
+	a.front()
+
+	pos := 0
+	x, y := a.First(), b.First()
+
+	for x != nil || y != nil {
+		var e *El[T]
+
+		switch {
+		case y == nil:
+			e, x = x, x.Next()
+		case x == nil || a.less(y.val, x.val):
+			e, y = y, y.Next()
+		case !a.repeat && !a.less(x.val, y.val):
+			d := y
+			y = y.Next()
+			b.free(d)
+
+			continue
+		default:
+			e, x = x, x.Next()
+		}
+
+		pos++
+
+		for i := 0; i < e.height(); i++ {
+			a.up[i].link(i).next = e
+			e.link(i).dist = pos - a.pos[i]
+			a.setBack(e, i, a.up[i])
+
+			a.up[i] = e
+			a.pos[i] = pos
+		}
+	}
+
+	for i, e := range a.up {
+		e.link(i).next = nil
+	}
+
+	a.len = pos
+	b.clear()
+
+	return a
+}
+
+// tail fills l.up and l.pos with the last element at each level.
+func (l *List[T]) tail() {
+	cur := &l.zero
+	pos := 0
+
+	for i := cur.height() - 1; i >= 0; i-- {
+		for n := cur.nexti(i); n != nil; n = cur.nexti(i) {
+			pos += n.link(i).dist
+			cur = n
+		}
+
+		l.up[i] = cur
+		l.pos[i] = pos
+	}
+}
+
+// clear drops all elements without freeing them.
+func (l *List[T]) clear() {
+	for i := 0; i < l.zero.height(); i++ {
+		*l.zero.link(i) = link[T]{}
+	}
+
+	l.len = 0
+}
+
+func checkJoin[T any](a, b *List[T]) {
+	if a.zero.height() != b.zero.height() {
+		panic("skiplist: lists max heights differ")
+	}
+
+	if a.backlinks != b.backlinks {
+		panic("skiplist: lists back links settings differ")
+	}
+
+	if !a.repeat && b.repeat {
+		panic("skiplist: join of repeated list into not repeated one")
+	}
+}
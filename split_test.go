@@ -0,0 +1,124 @@
+package skiplist
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitConcat(t *testing.T) {
+	for _, at := range []int{-1, 0, 1, 50, 99, 100, 200} {
+		l := New(IntLess, WithSeed(*seed))
+
+		for i := 0; i < 100; i++ {
+			l.Put(i)
+		}
+
+		left, right := l.SplitAt(at)
+
+		assert.True(t, left == l)
+
+		n := min(max(at, 0), 100)
+
+		assert.Equal(t, n, left.Len())
+		assert.Equal(t, 100-n, right.Len())
+		checkDist(t, left)
+		checkDist(t, right)
+
+		if right.Len() != 0 {
+			assert.Equal(t, n, right.First().Value())
+			assert.Equal(t, 0, right.Rank(n))
+		}
+
+		j := Concat(left, right)
+
+		assert.True(t, j == left)
+		assert.Equal(t, 0, right.Len())
+		assert.Nil(t, right.First())
+		assert.Equal(t, 100, j.Len())
+		checkDist(t, j)
+
+		for i := 0; i < 100; i++ {
+			if e := j.At(i); e == nil || e.Value() != i {
+				t.Errorf("at %d: %v", i, e)
+			}
+		}
+	}
+}
+
+func TestConcatPanics(t *testing.T) {
+	a := New(IntLess)
+	b := New(IntLess)
+	r := NewRepeated(IntLess)
+
+	a.Put(1)
+	b.Put(1)
+	r.Put(1)
+
+	assert.Panics(t, func() { Concat(a, b) })
+	assert.Panics(t, func() { Concat(a, New(IntLess, WithMaxHeight(5))) })
+
+	assert.Equal(t, 2, Concat(r, b).Len())
+}
+
+func TestMerge(t *testing.T) {
+	a := NewRepeated(IntLess, WithSeed(*seed))
+	b := NewRepeated(IntLess, WithSeed(*seed+1))
+	var exp []int
+
+	for i := 0; i < 1000; i++ {
+		v := rnd.Intn(500)
+		exp = append(exp, v)
+
+		if rnd.Intn(2) == 0 {
+			a.Put(v)
+		} else {
+			b.Put(v)
+		}
+	}
+
+	m := Merge(a, b)
+
+	slices.Sort(exp)
+
+	assert.True(t, m == a)
+	assert.Equal(t, exp, slices.Collect(m.All()))
+	assert.Equal(t, len(exp), m.Len())
+	assert.Equal(t, 0, b.Len())
+	checkDist(t, m)
+
+	u := New(IntLess)
+	w := New(IntLess)
+
+	u.PutSorted([]int{1, 3, 5, 7})
+	w.PutSorted([]int{0, 3, 4, 7, 9})
+
+	Merge(u, w)
+
+	assert.Equal(t, []int{0, 1, 3, 4, 5, 7, 9}, slices.Collect(u.All()))
+	assert.Equal(t, 7, u.Len())
+	checkDist(t, u)
+}
+
+func TestJoinRepeatMismatch(t *testing.T) {
+	mk := func() (*List[int], *List[int]) {
+		a := New(IntLess)
+		a.Put(1)
+
+		b := NewRepeated(IntLess)
+		b.Put(2)
+		b.Put(2)
+
+		return a, b
+	}
+
+	assert.Panics(t, func() { Merge(mk()) })
+	assert.Panics(t, func() { Concat(mk()) })
+
+	a, b := mk()
+	b = Merge(b, a)
+
+	assert.Equal(t, []int{1, 2, 2}, slices.Collect(b.All()))
+	checkDist(t, b)
+}
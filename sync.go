@@ -0,0 +1,83 @@
+package skiplist
+
+import (
+	"iter"
+	"sync"
+)
+
+type (
+	// Reader is the read-only part of List.
+	// Read methods don't modify list state so they can be called concurrently.
+	Reader[T any] interface {
+		Len() int
+		First() *El[T]
+		Last() *El[T]
+		Prev(e *El[T]) *El[T]
+		Get(v T) *El[T]
+		GetLast(v T) *El[T]
+		Floor(v T) *El[T]
+		Ceil(v T) *El[T]
+		Lower(v T) *El[T]
+		Higher(v T) *El[T]
+		At(i int) *El[T]
+		Rank(v T) int
+		CountRange(lo, hi T) int
+		Iter() *Iterator[T]
+		IterRange(lo, hi T) *Iterator[T]
+		All() iter.Seq[T]
+		Range(lo, hi T) iter.Seq[T]
+	}
+
+	// Writer is the full List interface.
+	Writer[T any] interface {
+		Reader[T]
+
+		Put(v T) (*El[T], bool)
+		PutBefore(v T) (*El[T], bool)
+		GetOrPut(v T) (*El[T], bool)
+		Del(v T) (T, bool)
+		DelEl(e *El[T]) bool
+		DelIf(v T, f func(*El[T]) bool) (T, bool)
+		DelAt(i int) (T, bool)
+	}
+
+	// SyncList is a List protected by RWMutex.
+	// Elements must not be used outside of View or Update callback they were got in.
+	SyncList[T any] struct {
+		mu sync.RWMutex
+		l  *List[T]
+	}
+)
+
+var _ Writer[int] = &List[int]{}
+
+// NewSync wraps l with RWMutex.
+// l must not be used directly after that.
+func NewSync[T any](l *List[T]) *SyncList[T] {
+	return &SyncList[T]{l: l}
+}
+
+// View calls f under read lock.
+// Multiple Views can run concurrently.
+func (s *SyncList[T]) View(f func(r Reader[T])) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	f(s.l)
+}
+
+// Update calls f under write lock.
+func (s *SyncList[T]) Update(f func(w Writer[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f(s.l)
+}
+
+// Len returns length of list.
+func (s *SyncList[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.l.Len()
+}
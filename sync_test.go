@@ -0,0 +1,68 @@
+package skiplist
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncList(t *testing.T) {
+	const G = 8
+	const M = 1000
+
+	s := NewSync(New(IntLess))
+
+	var wg sync.WaitGroup
+
+	for g := 0; g < G; g++ {
+		wg.Add(2)
+
+		go func(g int) {
+			defer wg.Done()
+
+			for i := 0; i < M; i++ {
+				v := i*G + g
+
+				s.Update(func(w Writer[int]) {
+					w.Put(v)
+					w.Put(v + M*G)
+					w.Del(v + M*G)
+				})
+			}
+		}(g)
+
+		go func(g int) {
+			defer wg.Done()
+
+			rnd := rand.New(rand.NewSource(int64(g)))
+
+			for i := 0; i < M; i++ {
+				s.View(func(r Reader[int]) {
+					v := rnd.Intn(M * G)
+
+					if e := r.Get(v); e != nil && e.Value() != v {
+						t.Errorf("got %v for %v", e.Value(), v)
+					}
+
+					if r.Len() != 0 {
+						assert.True(t, r.First() == r.At(0))
+					}
+				})
+			}
+		}(g)
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, M*G, s.Len())
+
+	s.View(func(r Reader[int]) {
+		i := 0
+		for v := range r.All() {
+			assert.Equal(t, i, v)
+			i++
+		}
+	})
+}